@@ -0,0 +1,101 @@
+package orderedmap
+
+import "iter"
+
+// BoundedOrderedMap is an OrderedMap holding at most a fixed number of keys, suitable for use as an LRU cache.
+//
+// Keys are ordered from least to most recently used. Setting a new key on a full map evicts the front (oldest) key.
+// Only operations which respect the bound are exposed; use Unwrap for read access to the full OrderedMap API.
+type BoundedOrderedMap[K comparable, V any] struct {
+	// OnEvict, if set, is invoked with each key and value evicted to make room for a new key.
+	// It is not invoked for keys removed explicitly via Remove.
+	OnEvict func(K, V)
+	// PromoteOnGet moves keys accessed via Get or updated via Set to the back, making them the last to be evicted.
+	PromoteOnGet bool
+
+	m        *OrderedMap[K, V]
+	capacity int
+}
+
+// NewBounded initializes a new BoundedOrderedMap holding at most capacity keys.
+// A capacity less than one results in every new key being evicted immediately.
+func NewBounded[K comparable, V any](capacity int) *BoundedOrderedMap[K, V] {
+	return &BoundedOrderedMap[K, V]{
+		m:        NewWithCapacity[K, V](capacity),
+		capacity: max(capacity, 0),
+	}
+}
+
+// Set a key of type K to a value of type V, evicting the oldest keys if the map exceeds its capacity.
+// If the key exists, the value will be modified and, if PromoteOnGet is enabled, the key moved to the back.
+func (b *BoundedOrderedMap[K, V]) Set(key K, value V) *BoundedOrderedMap[K, V] {
+	exists := b.m.Contains(key)
+	b.m.Set(key, value)
+	if exists {
+		b.promote(key)
+		return b
+	}
+
+	for b.m.Len() > b.capacity {
+		evicted, _ := b.m.PopFirst()
+		if b.OnEvict != nil {
+			b.OnEvict(evicted.Key, evicted.Value)
+		}
+	}
+	return b
+}
+
+// Get the value stored at the key. If PromoteOnGet is enabled, the key is moved to the back.
+func (b *BoundedOrderedMap[K, V]) Get(key K) (*V, bool) {
+	value, ok := b.m.Get(key)
+	if ok {
+		b.promote(key)
+	}
+	return value, ok
+}
+
+// Peek gets the value stored at the key without promoting it, regardless of PromoteOnGet.
+func (b *BoundedOrderedMap[K, V]) Peek(key K) (*V, bool) {
+	return b.m.Get(key)
+}
+
+// Contains reports whether key exists in the map, without promoting it.
+func (b *BoundedOrderedMap[K, V]) Contains(key K) bool {
+	return b.m.Contains(key)
+}
+
+// Remove the value stored at key, returning the removed KeyValuePair. OnEvict is not invoked.
+func (b *BoundedOrderedMap[K, V]) Remove(key K) (*KeyValuePair[K, V], bool) {
+	return b.m.Remove(key)
+}
+
+// Len returns the number of keys in the map.
+func (b *BoundedOrderedMap[K, V]) Len() int {
+	return b.m.Len()
+}
+
+// Cap returns the maximum number of keys held by the map.
+func (b *BoundedOrderedMap[K, V]) Cap() int {
+	return b.capacity
+}
+
+// Keys returns all keys, ordered from least to most recently used.
+func (b *BoundedOrderedMap[K, V]) Keys() []K {
+	return b.m.Keys()
+}
+
+// All returns an iterator over the keys and values, ordered from least to most recently used.
+func (b *BoundedOrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return b.m.All()
+}
+
+// Unwrap returns the underlying OrderedMap. Inserting keys directly into the returned map bypasses the capacity bound.
+func (b *BoundedOrderedMap[K, V]) Unwrap() *OrderedMap[K, V] {
+	return b.m
+}
+
+func (b *BoundedOrderedMap[K, V]) promote(key K) {
+	if b.PromoteOnGet {
+		_ = b.m.MoveToBack(key)
+	}
+}
@@ -0,0 +1,128 @@
+package orderedmap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBoundedOrderedMap_Set(t *testing.T) {
+	type testCase struct {
+		name        string
+		capacity    int
+		promote     bool
+		manip       func(b *BoundedOrderedMap[string, int])
+		want        *OrderedMap[string, int]
+		wantEvicted []KeyValuePair[string, int]
+	}
+	tests := []testCase{
+		{
+			name:     "under capacity evicts nothing",
+			capacity: 3,
+			manip: func(b *BoundedOrderedMap[string, int]) {
+				b.Set("a", 1).Set("b", 2)
+			},
+			want: newFromPairs(kvp("a", 1), kvp("b", 2)),
+		},
+		{
+			name:     "over capacity evicts oldest",
+			capacity: 2,
+			manip: func(b *BoundedOrderedMap[string, int]) {
+				b.Set("a", 1).Set("b", 2).Set("c", 3).Set("d", 4)
+			},
+			want:        newFromPairs(kvp("c", 3), kvp("d", 4)),
+			wantEvicted: []KeyValuePair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+		},
+		{
+			name:     "updating existing key does not evict",
+			capacity: 2,
+			manip: func(b *BoundedOrderedMap[string, int]) {
+				b.Set("a", 1).Set("b", 2).Set("a", 10)
+			},
+			want: newFromPairs(kvp("a", 10), kvp("b", 2)),
+		},
+		{
+			name:     "updating existing key promotes when enabled",
+			capacity: 2,
+			promote:  true,
+			manip: func(b *BoundedOrderedMap[string, int]) {
+				b.Set("a", 1).Set("b", 2).Set("a", 10).Set("c", 3)
+			},
+			want:        newFromPairs(kvp("a", 10), kvp("c", 3)),
+			wantEvicted: []KeyValuePair[string, int]{{Key: "b", Value: 2}},
+		},
+		{
+			name:     "get promotes when enabled",
+			capacity: 2,
+			promote:  true,
+			manip: func(b *BoundedOrderedMap[string, int]) {
+				b.Set("a", 1).Set("b", 2)
+				b.Get("a")
+				b.Set("c", 3)
+			},
+			want:        newFromPairs(kvp("a", 1), kvp("c", 3)),
+			wantEvicted: []KeyValuePair[string, int]{{Key: "b", Value: 2}},
+		},
+		{
+			name:     "get does not promote when disabled",
+			capacity: 2,
+			manip: func(b *BoundedOrderedMap[string, int]) {
+				b.Set("a", 1).Set("b", 2)
+				b.Get("a")
+				b.Set("c", 3)
+			},
+			want:        newFromPairs(kvp("b", 2), kvp("c", 3)),
+			wantEvicted: []KeyValuePair[string, int]{{Key: "a", Value: 1}},
+		},
+		{
+			name:     "peek does not promote",
+			capacity: 2,
+			promote:  true,
+			manip: func(b *BoundedOrderedMap[string, int]) {
+				b.Set("a", 1).Set("b", 2)
+				b.Peek("a")
+				b.Set("c", 3)
+			},
+			want:        newFromPairs(kvp("b", 2), kvp("c", 3)),
+			wantEvicted: []KeyValuePair[string, int]{{Key: "a", Value: 1}},
+		},
+		{
+			name:     "remove does not invoke OnEvict",
+			capacity: 2,
+			manip: func(b *BoundedOrderedMap[string, int]) {
+				b.Set("a", 1).Set("b", 2)
+				b.Remove("a")
+				b.Set("c", 3)
+			},
+			want: newFromPairs(kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:     "zero capacity evicts immediately",
+			capacity: 0,
+			manip: func(b *BoundedOrderedMap[string, int]) {
+				b.Set("a", 1)
+			},
+			want:        New[string, int](),
+			wantEvicted: []KeyValuePair[string, int]{{Key: "a", Value: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var evicted []KeyValuePair[string, int]
+			b := NewBounded[string, int](tt.capacity)
+			b.PromoteOnGet = tt.promote
+			b.OnEvict = func(k string, v int) {
+				evicted = append(evicted, KeyValuePair[string, int]{Key: k, Value: v})
+			}
+
+			tt.manip(b)
+
+			compareOrderedMaps(t, tt.want, b.Unwrap())
+			if b.Len() > b.Cap() {
+				t.Errorf("Len() = %d, which exceeds Cap() = %d", b.Len(), b.Cap())
+			}
+			if !reflect.DeepEqual(evicted, tt.wantEvicted) {
+				t.Errorf("OnEvict received %+v, want %+v", evicted, tt.wantEvicted)
+			}
+		})
+	}
+}
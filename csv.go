@@ -0,0 +1,39 @@
+package orderedmap
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// WriteCSV writes the map to w as two-column CSV records of key and value, in map order.
+//
+// Keys and values are formatted via fmt.Sprint. Use WriteCSVFunc to control formatting or to write a header,
+// for example to flatten struct values into one column per field.
+func (o *OrderedMap[K, V]) WriteCSV(w io.Writer) error {
+	return o.WriteCSVFunc(w, nil, func(k K, v V) []string {
+		return []string{fmt.Sprint(k), fmt.Sprint(v)}
+	})
+}
+
+// WriteCSVFunc writes the map to w as CSV, converting each pair into a record via row.
+//
+// If header is non-empty, it is written as the first record. Records are then written in map order.
+// The row function may return records of any width, allowing struct values to be flattened into multiple columns.
+func (o *OrderedMap[K, V]) WriteCSVFunc(w io.Writer, header []string, row func(K, V) []string) error {
+	writer := csv.NewWriter(w)
+	if len(header) > 0 {
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+	}
+
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		if err := writer.Write(row(e.Value.Key, e.Value.Value)); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
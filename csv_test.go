@@ -0,0 +1,103 @@
+package orderedmap
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestOrderedMap_WriteCSVFunc(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+	row := func(k string, v point) []string {
+		return []string{k, strconv.Itoa(v.X), strconv.Itoa(v.Y)}
+	}
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, point]
+		header []string
+		want   string
+	}
+	tests := []testCase{
+		{
+			name:   "empty map writes only the header",
+			o:      New[string, point](),
+			header: []string{"name", "x", "y"},
+			want:   "name,x,y\n",
+		},
+		{
+			name:   "three-column CSV from struct values in order",
+			o:      newFromPairs(kvp("origin", point{0, 0}), kvp("far, away", point{100, -5}), kvp("unit", point{1, 1})),
+			header: []string{"name", "x", "y"},
+			want:   "name,x,y\norigin,0,0\n\"far, away\",100,-5\nunit,1,1\n",
+		},
+		{
+			name: "no header",
+			o:    newFromPairs(kvp("unit", point{1, 1})),
+			want: "unit,1,1\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			if err := tt.o.WriteCSVFunc(&buf, tt.header, row); err != nil {
+				t.Fatalf("WriteCSVFunc() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("WriteCSVFunc() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("surfaces writer errors", func(t *testing.T) {
+		o := newFromPairs(kvp("unit", point{1, 1}))
+		if err := o.WriteCSVFunc(failingWriter{}, nil, row); err == nil {
+			t.Errorf("WriteCSVFunc() error = nil, want error")
+		}
+	})
+}
+
+func TestOrderedMap_WriteCSV(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, any]
+		want string
+	}
+	tests := []testCase{
+		{
+			name: "empty map writes nothing",
+			o:    New[string, any](),
+			want: "",
+		},
+		{
+			name: "two-column CSV in order",
+			o:    newFromPairs[string, any](kvp[string, any]("zebra", 26), kvp[string, any]("far, away", "yes"), kvp[string, any]("list", []int{1, 2})),
+			want: "zebra,26\n\"far, away\",yes\nlist,[1 2]\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			if err := tt.o.WriteCSV(&buf); err != nil {
+				t.Fatalf("WriteCSV() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("WriteCSV() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("surfaces writer errors", func(t *testing.T) {
+		if err := newFromPairs(kvp("a", 1)).WriteCSV(failingWriter{}); err == nil {
+			t.Errorf("WriteCSV() error = nil, want error")
+		}
+	})
+}
@@ -0,0 +1,163 @@
+package orderedmap
+
+import (
+	"strings"
+
+	"github.com/jimschubert/ordered-map/internal/myers"
+)
+
+// ChangeKind describes how a key differs between two maps compared via Diff.
+// Kinds are flags, so a key which was both moved and modified carries KeyMoved|ValueModified.
+type ChangeKind int
+
+const (
+	// KeyAdded signals that the key exists only in the new map.
+	KeyAdded ChangeKind = 1 << iota
+	// KeyRemoved signals that the key exists only in the old map.
+	KeyRemoved
+	// ValueModified signals that the key exists in both maps with values which are not equal. Nested *OrderedMap values
+	// are compared via Equal, and all other values via reflect.DeepEqual.
+	ValueModified
+	// KeyMoved signals that the key exists in both maps, but its position relative to other keys has changed.
+	KeyMoved
+)
+
+// Has reports whether all flags of other are set on c.
+func (c ChangeKind) Has(other ChangeKind) bool {
+	return c&other == other
+}
+
+// String provides a string representation of this ChangeKind.
+func (c ChangeKind) String() string {
+	names := make([]string, 0, 2)
+	for _, kind := range []struct {
+		flag ChangeKind
+		name string
+	}{
+		{KeyAdded, "added"},
+		{KeyRemoved, "removed"},
+		{ValueModified, "modified"},
+		{KeyMoved, "moved"},
+	} {
+		if c.Has(kind.flag) {
+			names = append(names, kind.name)
+		}
+	}
+	if len(names) == 0 {
+		return "unchanged"
+	}
+	return strings.Join(names, "|")
+}
+
+// Change describes how a single key differs between two maps compared via Diff.
+//
+// OldIndex and OldValue describe the key in the old map, and are -1 and the zero value respectively for KeyAdded.
+// NewIndex and NewValue describe the key in the new map, and are -1 and the zero value respectively for KeyRemoved.
+type Change[K comparable, V any] struct {
+	Kind     ChangeKind
+	Key      K
+	OldIndex int
+	NewIndex int
+	OldValue V
+	NewValue V
+}
+
+// Diff returns the changes required to turn old into new, including added, removed, modified, and moved keys.
+// Keys which are unchanged are omitted. A nil map is treated as empty.
+//
+// Moves are determined from the shortest edit script between the key sequences of old and new, so that keys which
+// retain their relative order are not reported as moved. Changes are ordered by that edit script, allowing them
+// to be rendered top to bottom.
+func Diff[K comparable, V any](old, new *OrderedMap[K, V]) []Change[K, V] {
+	var oldPairs, newPairs []KeyValuePair[K, V]
+	if old != nil {
+		oldPairs = old.Pairs()
+	}
+	if new != nil {
+		newPairs = new.Pairs()
+	}
+
+	oldKeys := make([]K, len(oldPairs))
+	oldIndex := make(map[K]int, len(oldPairs))
+	for i, p := range oldPairs {
+		oldKeys[i] = p.Key
+		oldIndex[p.Key] = i
+	}
+	newKeys := make([]K, len(newPairs))
+	newIndex := make(map[K]int, len(newPairs))
+	for i, p := range newPairs {
+		newKeys[i] = p.Key
+		newIndex[p.Key] = i
+	}
+
+	changes := make([]Change[K, V], 0)
+	for _, edit := range myers.Script(oldKeys, newKeys) {
+		switch edit.Op {
+		case myers.Equal:
+			o, n := oldPairs[edit.Lhs], newPairs[edit.Rhs]
+			if !valuesEqual(o.Value, n.Value) {
+				changes = append(changes, Change[K, V]{
+					Kind: ValueModified, Key: o.Key,
+					OldIndex: edit.Lhs, NewIndex: edit.Rhs,
+					OldValue: o.Value, NewValue: n.Value,
+				})
+			}
+		case myers.Delete:
+			o := oldPairs[edit.Lhs]
+			if _, moved := newIndex[o.Key]; moved {
+				// reported where the key is inserted in the new order
+				continue
+			}
+			changes = append(changes, Change[K, V]{
+				Kind: KeyRemoved, Key: o.Key,
+				OldIndex: edit.Lhs, NewIndex: -1,
+				OldValue: o.Value,
+			})
+		case myers.Insert:
+			n := newPairs[edit.Rhs]
+			i, moved := oldIndex[n.Key]
+			if !moved {
+				changes = append(changes, Change[K, V]{
+					Kind: KeyAdded, Key: n.Key,
+					OldIndex: -1, NewIndex: edit.Rhs,
+					NewValue: n.Value,
+				})
+				continue
+			}
+			change := Change[K, V]{
+				Kind: KeyMoved, Key: n.Key,
+				OldIndex: i, NewIndex: edit.Rhs,
+				OldValue: oldPairs[i].Value, NewValue: n.Value,
+			}
+			if !valuesEqual(change.OldValue, change.NewValue) {
+				change.Kind |= ValueModified
+			}
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// DiffString renders the differences between old and new as a line diff of their GoString representations,
+// colorized for display in a terminal. Returns false if the maps are Equal.
+func DiffString[K comparable, V any](old, new *OrderedMap[K, V]) (string, bool) {
+	return diffString(old, new, myers.DiffOptions{})
+}
+
+// DiffStringPlain is DiffString without ANSI escape codes, suitable for logs and golden files.
+func DiffStringPlain[K comparable, V any](old, new *OrderedMap[K, V]) (string, bool) {
+	return diffString(old, new, myers.DiffOptions{Plain: true})
+}
+
+func diffString[K comparable, V any](old, new *OrderedMap[K, V], opts myers.DiffOptions) (string, bool) {
+	if Equal(old, new) {
+		return "", false
+	}
+
+	if diff, ok := opts.DiffLines(old.GoString(), new.GoString()); ok {
+		return diff, true
+	}
+
+	// values differ in a way which GoString doesn't expose, so show both in full
+	return "old:\n" + old.GoString() + "\nnew:\n" + new.GoString(), true
+}
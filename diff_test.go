@@ -0,0 +1,157 @@
+package orderedmap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	type testCase struct {
+		name string
+		old  *OrderedMap[string, string]
+		new  *OrderedMap[string, string]
+		want []Change[string, string]
+	}
+	tests := []testCase{
+		{
+			name: "nil maps have no changes",
+			old:  nil,
+			new:  nil,
+			want: []Change[string, string]{},
+		},
+		{
+			name: "equal maps have no changes",
+			old:  newFromPairs(kvp("a", "1"), kvp("b", "2")),
+			new:  newFromPairs(kvp("a", "1"), kvp("b", "2")),
+			want: []Change[string, string]{},
+		},
+		{
+			name: "added to empty map",
+			old:  New[string, string](),
+			new:  newFromPairs(kvp("a", "1")),
+			want: []Change[string, string]{
+				{Kind: KeyAdded, Key: "a", OldIndex: -1, NewIndex: 0, NewValue: "1"},
+			},
+		},
+		{
+			name: "added, removed, and modified",
+			old:  newFromPairs(kvp("a", "1"), kvp("b", "2"), kvp("c", "3")),
+			new:  newFromPairs(kvp("a", "1"), kvp("c", "30"), kvp("d", "4")),
+			want: []Change[string, string]{
+				{Kind: KeyRemoved, Key: "b", OldIndex: 1, NewIndex: -1, OldValue: "2"},
+				{Kind: ValueModified, Key: "c", OldIndex: 2, NewIndex: 1, OldValue: "3", NewValue: "30"},
+				{Kind: KeyAdded, Key: "d", OldIndex: -1, NewIndex: 2, NewValue: "4"},
+			},
+		},
+		{
+			name: "moved and modified",
+			old:  newFromPairs(kvp("a", "1"), kvp("b", "2"), kvp("c", "3"), kvp("d", "4"), kvp("e", "5"), kvp("f", "6")),
+			new:  newFromPairs(kvp("a", "1"), kvp("c", "3"), kvp("d", "4"), kvp("e", "5"), kvp("b", "B"), kvp("f", "6")),
+			want: []Change[string, string]{
+				{Kind: KeyMoved | ValueModified, Key: "b", OldIndex: 1, NewIndex: 4, OldValue: "2", NewValue: "B"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Diff(tt.old, tt.new); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiff_nestedMaps(t *testing.T) {
+	edited := New[string, int]().Set("a", 1).Set("tmp", 0)
+	edited.Remove("tmp")
+
+	old := New[string, *OrderedMap[string, int]]().
+		Set("same", New[string, int]().Set("a", 1)).
+		Set("moved", New[string, int]().Set("a", 1)).
+		Set("last", nil)
+	updated := New[string, *OrderedMap[string, int]]().
+		Set("same", edited).
+		Set("last", nil).
+		Set("moved", edited)
+
+	got := Diff(old, updated)
+	if len(got) != 1 || got[0].Kind != KeyMoved || got[0].Key != "moved" {
+		t.Errorf("Diff() = %+v, want only a KeyMoved change for %q", got, "moved")
+	}
+}
+
+func TestChangeKind_String(t *testing.T) {
+	tests := []struct {
+		kind ChangeKind
+		want string
+	}{
+		{0, "unchanged"},
+		{KeyAdded, "added"},
+		{KeyMoved | ValueModified, "modified|moved"},
+	}
+	for _, tt := range tests {
+		if got := tt.kind.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestDiffString(t *testing.T) {
+	type testCase struct {
+		name      string
+		old       *OrderedMap[string, int]
+		new       *OrderedMap[string, int]
+		wantPlain string
+		wantColor string
+		wantOk    bool
+	}
+	tests := []testCase{
+		{
+			name:   "nil maps are equal",
+			old:    nil,
+			new:    nil,
+			wantOk: false,
+		},
+		{
+			name:   "equal maps are equal",
+			old:    newFromPairs(kvp("a", 1), kvp("b", 2)),
+			new:    newFromPairs(kvp("a", 1), kvp("b", 2)),
+			wantOk: false,
+		},
+		{
+			name:      "modified value",
+			old:       newFromPairs(kvp("a", 1), kvp("b", 2)),
+			new:       newFromPairs(kvp("a", 1), kvp("b", 20)),
+			wantPlain: " orderedmap.New[string,int]().\n \tSet(\"a\", 1).\n-\tSet(\"b\", 2)\n+\tSet(\"b\", 20)",
+			wantColor: " orderedmap.New[string,int]().\n \tSet(\"a\", 1).\n\033[31m-\tSet(\"b\", 2)\033[0m\n\033[32m+\tSet(\"b\", 20)\033[0m",
+			wantOk:    true,
+		},
+		{
+			name:      "added key",
+			old:       newFromPairs(kvp("a", 1)),
+			new:       newFromPairs(kvp("b", 2), kvp("a", 1)),
+			wantPlain: " orderedmap.New[string,int]().\n+\tSet(\"b\", 2).\n \tSet(\"a\", 1)",
+			wantColor: " orderedmap.New[string,int]().\n\033[32m+\tSet(\"b\", 2).\033[0m\n \tSet(\"a\", 1)",
+			wantOk:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DiffStringPlain(tt.old, tt.new)
+			if got != tt.wantPlain {
+				t.Errorf("DiffStringPlain() got = %q, want %q", got, tt.wantPlain)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("DiffStringPlain() ok = %v, want %v", ok, tt.wantOk)
+			}
+
+			got, ok = DiffString(tt.old, tt.new)
+			if got != tt.wantColor {
+				t.Errorf("DiffString() got = %q, want %q", got, tt.wantColor)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("DiffString() ok = %v, want %v", ok, tt.wantOk)
+			}
+		})
+	}
+}
@@ -15,7 +15,13 @@ Construct a map with contents as follows:
 		Set("Second", "2nd").
 		Set("Third", "3rd")
 
-Iterate the map with the provided iterator:
+Iterate the map with range-over-func (Go 1.23+):
+
+	for key, value := range myMap.All() {
+		fmt.Printf("Shorthand for %q is %q.\n", key, value)
+	}
+
+Or, with the provided iterator:
 
 	it := myMap.Iterator()
 	for i := it.Next(); i != nil; i = it.Next() {
@@ -0,0 +1,20 @@
+package orderedmap
+
+import "strings"
+
+// FromEnviron constructs an OrderedMap from KEY=VALUE strings, such as those returned by os.Environ.
+//
+// Entries are inserted in the order given, splitting each entry on the first '=' only, so values may themselves contain '='.
+// Entries which do not contain '=' are skipped. If a key is repeated, the later value wins while the key retains its
+// original position.
+func FromEnviron(environ []string) *OrderedMap[string, string] {
+	m := New[string, string]()
+	for _, entry := range environ {
+		key, value, found := strings.Cut(entry, "=")
+		if !found {
+			continue
+		}
+		m.Set(key, value)
+	}
+	return m
+}
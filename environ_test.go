@@ -0,0 +1,43 @@
+package orderedmap
+
+import "testing"
+
+func TestFromEnviron(t *testing.T) {
+	type testCase struct {
+		name    string
+		environ []string
+		want    *OrderedMap[string, string]
+	}
+	tests := []testCase{
+		{
+			name:    "empty environ yields empty map",
+			environ: []string{},
+			want:    New[string, string](),
+		},
+		{
+			name:    "preserves given order",
+			environ: []string{"SHELL=/bin/zsh", "HOME=/home/jim", "EDITOR=vim"},
+			want:    newFromPairs(kvp("SHELL", "/bin/zsh"), kvp("HOME", "/home/jim"), kvp("EDITOR", "vim")),
+		},
+		{
+			name:    "splits on first '=' only",
+			environ: []string{"OPTS=--level=debug --mode=fast", "EMPTY=", "EQ=="},
+			want:    newFromPairs(kvp("OPTS", "--level=debug --mode=fast"), kvp("EMPTY", ""), kvp("EQ", "=")),
+		},
+		{
+			name:    "skips entries without '='",
+			environ: []string{"FIRST=1", "garbage", "SECOND=2"},
+			want:    newFromPairs(kvp("FIRST", "1"), kvp("SECOND", "2")),
+		},
+		{
+			name:    "repeated keys keep original position with last value",
+			environ: []string{"A=1", "B=2", "A=3"},
+			want:    newFromPairs(kvp("A", "3"), kvp("B", "2")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compareOrderedMaps(t, tt.want, FromEnviron(tt.environ))
+		})
+	}
+}
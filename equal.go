@@ -1,19 +1,25 @@
 package orderedmap
 
-import "reflect"
+import (
+	"reflect"
+
+	"github.com/jimschubert/ordered-map/internal/myers"
+)
 
 // Equal is a lock-free evaluation of two OrderedMap values. It is up to the user to
 // lock these maps for thread-safe equality check.
 //
 // This optimizes equality of key/value pairs, ignoring the internals of the data structure.
-// If the caller invokes reflect.DeepEqual on equivalent maps, the result should be the same.
-// However, reflect.DeepEqual evaluates both exported and unexported fields which unnecessary overhead.
+// Values which are themselves an *OrderedMap are compared recursively via Equal, so the internals of nested maps are
+// ignored as well. All other values fall back to reflect.DeepEqual.
+//
+// Prefer Equal over reflect.DeepEqual on maps themselves: the internals include a modification count, so maps with
+// the same pairs in the same order but a different edit history are not reflect.DeepEqual.
 //
-// This implementation will incur the overhead of reflect.DeepEqual mentioned above if any key in the OrderedMap refers
-// to an OrderedMap value.
+// Two nil maps are equal, while a nil map is never equal to a non-nil map (even if that map is empty).
 func Equal[K comparable, V any](x, y *OrderedMap[K, V]) bool {
-	if (x == nil && y != nil) || (y == nil && x != nil) {
-		return false
+	if x == nil || y == nil {
+		return x == y
 	}
 	if x.order.Len() != y.order.Len() {
 		return false
@@ -45,7 +51,87 @@ func Equal[K comparable, V any](x, y *OrderedMap[K, V]) bool {
 			return false
 		}
 
-		if !reflect.DeepEqual(xCurrent.Value, yCurrent.Value) {
+		if !valuesEqual(xCurrent.Value, yCurrent.Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// KeyOrderDistance returns the number of key insertions and deletions required to turn the key sequence of a into the
+// key sequence of b. Values are not considered.
+//
+// Moving a single key elsewhere in the order is counted as one deletion and one insertion. A nil map is treated as empty.
+func KeyOrderDistance[K comparable, V any](a, b *OrderedMap[K, V]) int {
+	var lhs, rhs []K
+	if a != nil {
+		lhs = a.Keys()
+	}
+	if b != nil {
+		rhs = b.Keys()
+	}
+	return myers.Distance(lhs, rhs)
+}
+
+// deepEqualer allows Equal to recurse into nested OrderedMap values of any type parameters without reflection.
+//
+// Types which embed *OrderedMap also satisfy this interface via the promoted method, so equalDeep reports whether x
+// was exactly an *OrderedMap[K, V] and therefore handled.
+type deepEqualer interface {
+	equalDeep(x, y any) (equal, handled bool)
+}
+
+func (o *OrderedMap[K, V]) equalDeep(x, y any) (equal, handled bool) {
+	xm, ok := x.(*OrderedMap[K, V])
+	if !ok {
+		return false, false
+	}
+	ym, ok := y.(*OrderedMap[K, V])
+	if !ok {
+		return false, true
+	}
+	return Equal(xm, ym), true
+}
+
+// valuesEqual compares x and y, recursing into nested OrderedMap values and otherwise using reflect.DeepEqual.
+func valuesEqual[V any](x, y V) bool {
+	xv, yv := any(x), any(y)
+	if nested, ok := xv.(deepEqualer); ok {
+		if equal, handled := nested.equalDeep(xv, yv); handled {
+			return equal
+		}
+	}
+	return reflect.DeepEqual(xv, yv)
+}
+
+// EqualDeep is a lock-free evaluation of two OrderedMap values, comparing keys positionally.
+// It is up to the user to lock these maps for thread-safe equality check.
+//
+// Deprecated: Equal now compares nested *OrderedMap values recursively without reflection, so EqualDeep is
+// equivalent to Equal and remains only for compatibility.
+func EqualDeep[K comparable, V any](x, y *OrderedMap[K, V]) bool {
+	return Equal(x, y)
+}
+
+// EqualUnordered is a lock-free evaluation of two OrderedMap values as sets of key/value pairs, ignoring order.
+// It is up to the user to lock these maps for thread-safe equality check.
+//
+// This differs from Equal, which also requires pairs to appear in the same order. Here, x and y are equal if they have
+// the same length and every key of x exists in y with an equal value. As with Equal, nested *OrderedMap values are
+// compared recursively via Equal and all other values fall back to reflect.DeepEqual.
+// Also as with Equal, two nil maps are equal, while a nil map is never equal to a non-nil map.
+func EqualUnordered[K comparable, V any](x, y *OrderedMap[K, V]) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.items) != len(y.items) {
+		return false
+	}
+
+	for key, xPair := range x.items {
+		yPair, ok := y.items[key]
+		if !ok || !valuesEqual(xPair.Value, yPair.Value) {
 			return false
 		}
 	}
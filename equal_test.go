@@ -0,0 +1,301 @@
+package orderedmap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeyOrderDistance(t *testing.T) {
+	type testCase struct {
+		name string
+		a    *OrderedMap[string, int]
+		b    *OrderedMap[string, int]
+		want int
+	}
+	tests := []testCase{
+		{
+			name: "identical key sequences",
+			a:    newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			b:    newFromPairs(kvp("a", 10), kvp("b", 20), kvp("c", 30)),
+			want: 0,
+		},
+		{
+			name: "empty and nil maps",
+			a:    New[string, int](),
+			b:    nil,
+			want: 0,
+		},
+		{
+			name: "reordered key sequence",
+			a:    newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			b:    newFromPairs(kvp("b", 2), kvp("c", 3), kvp("d", 4), kvp("a", 1)),
+			want: 2,
+		},
+		{
+			name: "disjoint key sequences",
+			a:    newFromPairs(kvp("a", 1), kvp("b", 2)),
+			b:    newFromPairs(kvp("x", 1), kvp("y", 2), kvp("z", 3)),
+			want: 5,
+		},
+		{
+			name: "added and removed keys",
+			a:    newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			b:    newFromPairs(kvp("a", 1), kvp("c", 3), kvp("d", 4)),
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KeyOrderDistance(tt.a, tt.b); got != tt.want {
+				t.Errorf("KeyOrderDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqual_nested(t *testing.T) {
+	nested := func(leaf string) *OrderedMap[string, any] {
+		inner := New[string, any]().Set("leaf", leaf).Set("list", []int{1, 2})
+		return New[string, any]().Set("inner", inner).Set("n", 1)
+	}
+	type testCase struct {
+		name string
+		x    *OrderedMap[string, any]
+		y    *OrderedMap[string, any]
+		want bool
+	}
+	tests := []testCase{
+		{name: "both nil", x: nil, y: nil, want: true},
+		{name: "one nil", x: New[string, any](), y: nil, want: false},
+		{name: "both empty", x: New[string, any](), y: New[string, any](), want: true},
+		{
+			name: "equal nested maps",
+			x:    New[string, any]().Set("a", nested("x")).Set("b", "value"),
+			y:    New[string, any]().Set("a", nested("x")).Set("b", "value"),
+			want: true,
+		},
+		{
+			name: "unequal nested leaf",
+			x:    New[string, any]().Set("a", nested("x")),
+			y:    New[string, any]().Set("a", nested("y")),
+			want: false,
+		},
+		{
+			name: "same keys in different order",
+			x:    New[string, any]().Set("a", 1).Set("b", 2),
+			y:    New[string, any]().Set("b", 2).Set("a", 1),
+			want: false,
+		},
+		{
+			name: "nested map compared against a non-map value",
+			x:    New[string, any]().Set("a", nested("x")),
+			y:    New[string, any]().Set("a", "x"),
+			want: false,
+		},
+		{
+			name: "nested maps with different type parameters",
+			x:    New[string, any]().Set("a", New[string, int]().Set("x", 1)),
+			y:    New[string, any]().Set("a", New[string, int64]().Set("x", 1)),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Equal(tt.x, tt.y); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := EqualDeep(tt.x, tt.y); got != tt.want {
+				t.Errorf("EqualDeep() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func nestedForBenchmark(depth, width int) *OrderedMap[string, any] {
+	m := New[string, any]()
+	for i := 0; i < width; i++ {
+		key := string(rune('a' + i))
+		if depth > 0 {
+			m.Set(key, nestedForBenchmark(depth-1, width))
+		} else {
+			m.Set(key, i)
+		}
+	}
+	return m
+}
+
+func BenchmarkEqual_nested(b *testing.B) {
+	x, y := nestedForBenchmark(4, 5), nestedForBenchmark(4, 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !Equal(x, y) {
+			b.Fatal("expected maps to be equal")
+		}
+	}
+}
+
+func BenchmarkEqualDeep_nested(b *testing.B) {
+	x, y := nestedForBenchmark(4, 5), nestedForBenchmark(4, 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !EqualDeep(x, y) {
+			b.Fatal("expected maps to be equal")
+		}
+	}
+}
+
+// BenchmarkDeepEqual_nested is a baseline for BenchmarkEqual_nested, comparing the same maps via reflection.
+func BenchmarkDeepEqual_nested(b *testing.B) {
+	x, y := nestedForBenchmark(4, 5), nestedForBenchmark(4, 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !reflect.DeepEqual(x, y) {
+			b.Fatal("expected maps to be equal")
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	type testCase struct {
+		name string
+		x    *OrderedMap[string, int]
+		y    *OrderedMap[string, int]
+		want bool
+	}
+	tests := []testCase{
+		{name: "nil and nil", x: nil, y: nil, want: true},
+		{name: "nil and empty", x: nil, y: New[string, int](), want: false},
+		{name: "empty and nil", x: New[string, int](), y: nil, want: false},
+		{name: "empty and empty", x: New[string, int](), y: New[string, int](), want: true},
+		{
+			name: "same pairs in same order",
+			x:    newFromPairs(kvp("a", 1), kvp("b", 2)),
+			y:    newFromPairs(kvp("a", 1), kvp("b", 2)),
+			want: true,
+		},
+		{
+			name: "same pairs in different order",
+			x:    newFromPairs(kvp("a", 1), kvp("b", 2)),
+			y:    newFromPairs(kvp("b", 2), kvp("a", 1)),
+			want: false,
+		},
+		{
+			name: "different values",
+			x:    newFromPairs(kvp("a", 1)),
+			y:    newFromPairs(kvp("a", 2)),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Equal(tt.x, tt.y); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	t.Run("nested maps are compared by their pairs", func(t *testing.T) {
+		inner := func(extra bool) *OrderedMap[string, int] {
+			m := New[string, int]().Set("a", 1)
+			if extra {
+				// leaves the same pairs behind a different internal history
+				m.Set("b", 2).Remove("b")
+			}
+			return m
+		}
+		x := New[string, *OrderedMap[string, int]]().Set("inner", inner(false))
+		y := New[string, *OrderedMap[string, int]]().Set("inner", inner(true))
+		if !Equal(x, y) {
+			t.Errorf("Equal() = false for nested maps with equal pairs")
+		}
+
+		y.Set("inner", New[string, int]().Set("a", 2))
+		if Equal(x, y) {
+			t.Errorf("Equal() = true for nested maps with different pairs")
+		}
+	})
+
+	t.Run("values embedding a map fall back to reflect.DeepEqual", func(t *testing.T) {
+		type wrapper struct {
+			*OrderedMap[string, int]
+			name string
+		}
+		inner := New[string, int]().Set("a", 1)
+		x := New[string, wrapper]().Set("w", wrapper{inner, "x"})
+		y := New[string, wrapper]().Set("w", wrapper{inner, "x"})
+		if !Equal(x, y) {
+			t.Errorf("Equal() = false for identical wrapper values")
+		}
+
+		y.Set("w", wrapper{inner, "y"})
+		if Equal(x, y) {
+			t.Errorf("Equal() = true for wrapper values with different fields")
+		}
+
+		texts := New[string, TextMap]().Set("t", TextMap{New[string, string]().Set("k", "v")})
+		if !Equal(texts, Collect(texts.All())) {
+			t.Errorf("Equal() = false for identical TextMap values")
+		}
+	})
+}
+
+func TestEqualUnordered(t *testing.T) {
+	type testCase struct {
+		name string
+		x    *OrderedMap[string, []int]
+		y    *OrderedMap[string, []int]
+		want bool
+	}
+	tests := []testCase{
+		{name: "nil and nil", x: nil, y: nil, want: true},
+		{name: "nil and empty", x: nil, y: New[string, []int](), want: false},
+		{name: "empty and empty", x: New[string, []int](), y: New[string, []int](), want: true},
+		{
+			name: "same pairs in different order",
+			x:    newFromPairs(kvp("a", []int{1}), kvp("b", []int{2, 3})),
+			y:    newFromPairs(kvp("b", []int{2, 3}), kvp("a", []int{1})),
+			want: true,
+		},
+		{
+			name: "different values",
+			x:    newFromPairs(kvp("a", []int{1}), kvp("b", []int{2, 3})),
+			y:    newFromPairs(kvp("b", []int{3, 2}), kvp("a", []int{1})),
+			want: false,
+		},
+		{
+			name: "different keys with same length",
+			x:    newFromPairs(kvp("a", []int{1})),
+			y:    newFromPairs(kvp("z", []int{1})),
+			want: false,
+		},
+		{
+			name: "subset",
+			x:    newFromPairs(kvp("a", []int{1})),
+			y:    newFromPairs(kvp("a", []int{1}), kvp("b", []int{2})),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualUnordered(tt.x, tt.y); got != tt.want {
+				t.Errorf("EqualUnordered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqualUnordered_nestedMaps(t *testing.T) {
+	inner := New[string, int]().Set("a", 1)
+	edited := New[string, int]().Set("a", 1).Set("tmp", 0)
+	edited.Remove("tmp")
+
+	x := New[string, *OrderedMap[string, int]]().Set("k", inner).Set("other", nil)
+	y := New[string, *OrderedMap[string, int]]().Set("other", nil).Set("k", edited)
+	if !EqualUnordered(x, y) {
+		t.Errorf("EqualUnordered() = false, want true for nested maps with the same pairs but different edit history")
+	}
+
+	edited.Set("b", 2)
+	if EqualUnordered(x, y) {
+		t.Errorf("EqualUnordered() = true, want false for nested maps with different pairs")
+	}
+}
@@ -1,6 +1,18 @@
 package orderedmap
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrKeyNotFound is matched by errors.Is for any KeyNotFoundError, regardless of key type.
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrDuplicateKeyValue is matched by errors.Is for any DuplicateKeyValueError, regardless of key or value type.
+	ErrDuplicateKeyValue = errors.New("key already exists")
+	// ErrUnorderedKeys is matched by errors.Is for any UnorderedKeysError, regardless of key type.
+	ErrUnorderedKeys = errors.New("keys missing from order")
+)
 
 // KeyNotFoundError conveys to the caller that a key was requested but not found in the map
 type KeyNotFoundError[K comparable] struct {
@@ -12,6 +24,11 @@ func (k *KeyNotFoundError[K]) Error() string {
 	return fmt.Sprintf("key not found: %v", k.Key)
 }
 
+// Is reports whether target is ErrKeyNotFound, allowing errors.Is to match without knowing K.
+func (k *KeyNotFoundError[K]) Is(target error) bool {
+	return target == ErrKeyNotFound
+}
+
 func keyNotFound[K comparable](key K) *KeyNotFoundError[K] {
 	return &KeyNotFoundError[K]{Key: key}
 }
@@ -30,9 +47,30 @@ func (k *DuplicateKeyValueError[K, V]) Error() string {
 	return fmt.Sprintf("key %v already exists with value %v", k.Key, k.Value)
 }
 
+// Is reports whether target is ErrDuplicateKeyValue, allowing errors.Is to match without knowing K or V.
+func (k *DuplicateKeyValueError[K, V]) Is(target error) bool {
+	return target == ErrDuplicateKeyValue
+}
+
 func duplicateValue[K comparable, V any](key K, value V) *DuplicateKeyValueError[K, V] {
 	return &DuplicateKeyValueError[K, V]{
 		Key:   key,
 		Value: value,
 	}
 }
+
+// UnorderedKeysError conveys to the caller that keys were provided without a corresponding position, such as when
+// constructing a map via FromMap with an order which omits keys present in the source map.
+type UnorderedKeysError[K comparable] struct {
+	Keys []K
+}
+
+// Error provides a string representation of this error.
+func (u *UnorderedKeysError[K]) Error() string {
+	return fmt.Sprintf("keys missing from order: %v", u.Keys)
+}
+
+// Is reports whether target is ErrUnorderedKeys, allowing errors.Is to match without knowing K.
+func (u *UnorderedKeysError[K]) Is(target error) bool {
+	return target == ErrUnorderedKeys
+}
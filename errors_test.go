@@ -0,0 +1,78 @@
+package orderedmap
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	type testCase struct {
+		name   string
+		err    error
+		target error
+		wantIs bool
+		wantAs func(error) bool
+	}
+	tests := []testCase{
+		{
+			name:   "key not found",
+			err:    New[string, int]().MoveToFront("missing"),
+			target: ErrKeyNotFound,
+			wantIs: true,
+			wantAs: func(err error) bool {
+				var typed *KeyNotFoundError[string]
+				return errors.As(err, &typed) && typed.Key == "missing"
+			},
+		},
+		{
+			name:   "wrapped key not found",
+			err:    fmt.Errorf("moving: %w", New[int, int]().MoveToBack(42)),
+			target: ErrKeyNotFound,
+			wantIs: true,
+			wantAs: func(err error) bool {
+				var typed *KeyNotFoundError[int]
+				return errors.As(err, &typed) && typed.Key == 42
+			},
+		},
+		{
+			name:   "duplicate key value",
+			err:    newFromPairs(kvp("a", 1), kvp("b", 2)).InsertAfter("a", 3, "b"),
+			target: ErrDuplicateKeyValue,
+			wantIs: true,
+			wantAs: func(err error) bool {
+				var typed *DuplicateKeyValueError[string, int]
+				return errors.As(err, &typed) && typed.Key == "a"
+			},
+		},
+		{
+			name:   "unordered keys",
+			err:    func() error { _, err := FromMap(map[string]int{"a": 1, "b": 2}, []string{"a"}); return err }(),
+			target: ErrUnorderedKeys,
+			wantIs: true,
+			wantAs: func(err error) bool {
+				var typed *UnorderedKeysError[string]
+				return errors.As(err, &typed)
+			},
+		},
+		{
+			name:   "key not found is not a duplicate",
+			err:    New[string, int]().MoveToFront("missing"),
+			target: ErrDuplicateKeyValue,
+			wantIs: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil {
+				t.Fatalf("expected an error")
+			}
+			if got := errors.Is(tt.err, tt.target); got != tt.wantIs {
+				t.Errorf("errors.Is(%v, %v) = %v, want %v", tt.err, tt.target, got, tt.wantIs)
+			}
+			if tt.wantAs != nil && !tt.wantAs(tt.err) {
+				t.Errorf("errors.As(%v) did not match the typed error", tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package orderedmap
+
+import (
+	"cmp"
+	"slices"
+)
+
+// CapValues truncates each value of a slice-valued map to at most maxLen elements, keeping the leading elements.
+//
+// Truncated values are copied so that the elements beyond maxLen may be reclaimed. The order of keys is untouched.
+// A negative maxLen is treated as zero. Subscribers receive a ChangeUpdate for each truncated value.
+func CapValues[K comparable, V any](o *OrderedMap[K, []V], maxLen int) {
+	defer o.holdEvents()()
+	maxLen = max(maxLen, 0)
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		if old := e.Value.Value; len(old) > maxLen {
+			e.Value.Value = slices.Clone(old[:maxLen])
+			o.notify(ChangeUpdate, e.Value.Key, old, e.Value.Value)
+		}
+	}
+}
+
+// Scan returns a new map with the same keys as o, in order, where each value is the accumulation of fn over all
+// preceding pairs and the current pair, starting from initial.
+func Scan[K comparable, V, A any](o *OrderedMap[K, V], initial A, fn func(acc A, key K, value V) A) *OrderedMap[K, A] {
+	result := New[K, A]()
+	acc := initial
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		acc = fn(acc, e.Value.Key, e.Value.Value)
+		result.Set(e.Value.Key, acc)
+	}
+	return result
+}
+
+// CumulativeSum returns a new map where each value is the sum of itself and all preceding values in o (prefix sums).
+func CumulativeSum[K comparable](o *OrderedMap[K, int]) *OrderedMap[K, int] {
+	return Scan(o, 0, func(acc int, _ K, value int) int {
+		return acc + value
+	})
+}
+
+// MissingKeys returns the integers in the inclusive range [from, to] which are not keys of o, in ascending order.
+//
+// Only membership is considered; the order of o is ignored. If from is greater than to, the result is empty.
+func MissingKeys[V any](o *OrderedMap[int, V], from, to int) []int {
+	missing := make([]int, 0)
+	for i := from; i <= to; i++ {
+		if _, ok := o.items[i]; !ok {
+			missing = append(missing, i)
+		}
+		if i == to {
+			// avoid overflow when to is math.MaxInt
+			break
+		}
+	}
+	return missing
+}
+
+// BuildPaged constructs an OrderedMap by repeatedly invoking next to fetch pages of pairs, inserting each page in
+// order until next reports that no more pages are available.
+//
+// This allows for assembling a map from a paginated source while preserving the source's order.
+// If next returns an error, building stops and the error is returned to the caller along with a nil map.
+func BuildPaged[K comparable, V any](next func() ([]KeyValuePair[K, V], bool, error)) (*OrderedMap[K, V], error) {
+	m := New[K, V]()
+	for {
+		page, moreAvailable, err := next()
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range page {
+			m.Set(pair.Key, pair.Value)
+		}
+		if !moreAvailable {
+			return m, nil
+		}
+	}
+}
+
+// TopN returns the n entries of o with the highest values, sorted in descending order by value.
+// Entries with equal values retain their relative order from o. The map itself is not modified.
+//
+// If n is greater than the length of o, all entries are returned. A negative n is treated as zero.
+func TopN(o *OrderedMap[string, int], n int) []KeyValuePair[string, int] {
+	pairs := make([]KeyValuePair[string, int], 0, o.order.Len())
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		pairs = append(pairs, KeyValuePair[string, int]{Key: e.Value.Key, Value: e.Value.Value})
+	}
+
+	slices.SortStableFunc(pairs, func(a, b KeyValuePair[string, int]) int {
+		return cmp.Compare(b.Value, a.Value)
+	})
+
+	return pairs[:min(max(n, 0), len(pairs))]
+}
+
+// IsKeyPermutation reports whether the keys of o are exactly the integers 0 through n-1, in any order.
+//
+// Go does not allow methods to constrain the key type of OrderedMap, so this is provided as a package function.
+func IsKeyPermutation[V any](o *OrderedMap[int, V], n int) bool {
+	if n < 0 || len(o.items) != n {
+		return false
+	}
+	for key := range o.items {
+		if key < 0 || key >= n {
+			return false
+		}
+	}
+	return true
+}
+
+// ToSlice returns the values of o indexed by key if the keys of o are exactly 0 through n-1 (see IsKeyPermutation),
+// such that the result marshals to a JSON array. Returns nil and false if the keys are not dense.
+//
+// Note that the result is ordered by key, not by the order of the map.
+func ToSlice[V any](o *OrderedMap[int, V]) ([]V, bool) {
+	n := o.order.Len()
+	if !IsKeyPermutation(o, n) {
+		return nil, false
+	}
+
+	values := make([]V, n)
+	for key, pair := range o.items {
+		values[key] = pair.Value
+	}
+	return values, true
+}
+
+// MapValues returns a new map with the same keys as o, in order, where each value is the result of f applied to
+// the corresponding pair of o. The original map is not modified.
+func MapValues[K comparable, V, R any](o *OrderedMap[K, V], f func(K, V) R) *OrderedMap[K, R] {
+	result := New[K, R]()
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		_ = result.insertKeyValuePair(e.Value.Key, f(e.Value.Key, e.Value.Value))
+	}
+	return result
+}
+
+// Reduce folds f over the pairs of o in order, threading the accumulator from left to right starting with initial.
+func Reduce[K comparable, V, A any](o *OrderedMap[K, V], initial A, f func(A, K, V) A) A {
+	acc := initial
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		acc = f(acc, e.Value.Key, e.Value.Value)
+	}
+	return acc
+}
+
+// GroupBy partitions the pairs of o into groups keyed by keyFn. Groups are ordered by the first appearance of their
+// key in o, and the pairs within each group retain their relative order from o. The original map is not modified.
+// A nil map is treated as empty.
+func GroupBy[K comparable, V any, G comparable](o *OrderedMap[K, V], keyFn func(K, V) G) *OrderedMap[G, *OrderedMap[K, V]] {
+	groups := New[G, *OrderedMap[K, V]]()
+	if o == nil {
+		return groups
+	}
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		group := groups.ComputeIfAbsent(keyFn(e.Value.Key, e.Value.Value), New[K, V])
+		_ = group.insertKeyValuePair(e.Value.Key, e.Value.Value)
+	}
+	return groups
+}
+
+// MinByValue returns the pair of o with the least value according to less, scanning o once.
+// Among equal values, the first pair in map order wins. Returns false if o is empty.
+func MinByValue[K comparable, V any](o *OrderedMap[K, V], less func(a, b V) bool) (*KeyValuePair[K, V], bool) {
+	return extremeByValue(o, less)
+}
+
+// MaxByValue returns the pair of o with the greatest value according to less, scanning o once.
+// Among equal values, the first pair in map order wins. Returns false if o is empty.
+func MaxByValue[K comparable, V any](o *OrderedMap[K, V], less func(a, b V) bool) (*KeyValuePair[K, V], bool) {
+	return extremeByValue(o, func(a, b V) bool { return less(b, a) })
+}
+
+// extremeByValue returns the first pair of o whose value no other value precedes according to before.
+func extremeByValue[K comparable, V any](o *OrderedMap[K, V], before func(a, b V) bool) (*KeyValuePair[K, V], bool) {
+	front := o.order.Front()
+	if front == nil {
+		return nil, false
+	}
+
+	best := front.Value
+	for e := front.Next(); e != nil; e = e.Next() {
+		if before(e.Value.Value, best.Value) {
+			best = e.Value
+		}
+	}
+	return best, true
+}
@@ -0,0 +1,552 @@
+package orderedmap
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestCapValues(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, []int]
+		maxLen int
+		expect *OrderedMap[string, []int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map is unmodified",
+			o:      New[string, []int](),
+			maxLen: 2,
+			expect: New[string, []int](),
+		},
+		{
+			name:   "truncates longer values and keeps shorter values",
+			o:      newFromPairs(kvp("long", []int{1, 2, 3, 4, 5}), kvp("short", []int{1}), kvp("exact", []int{1, 2})),
+			maxLen: 2,
+			expect: newFromPairs(kvp("long", []int{1, 2}), kvp("short", []int{1}), kvp("exact", []int{1, 2})),
+		},
+		{
+			name:   "negative cap empties values",
+			o:      newFromPairs(kvp("a", []int{1, 2, 3})),
+			maxLen: -1,
+			expect: newFromPairs(kvp("a", []int{})),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			CapValues(tt.o, tt.maxLen)
+			compareOrderedMaps(t, tt.expect, tt.o)
+			for e := tt.o.order.Front(); e != nil; e = e.Next() {
+				if cap(e.Value.Value) > max(tt.maxLen, 0) {
+					t.Errorf("CapValues() retained capacity %d for key %q", cap(e.Value.Value), e.Value.Key)
+				}
+			}
+		})
+	}
+
+	t.Run("truncated values notify subscribers", func(t *testing.T) {
+		o := newFromPairs(kvp("long", []int{1, 2, 3}), kvp("short", []int{1}))
+		var got []ChangeEvent[string, []int]
+		o.OnChange(func(event ChangeEvent[string, []int]) {
+			got = append(got, event)
+		})
+
+		CapValues(o, 2)
+
+		want := []ChangeEvent[string, []int]{
+			{Op: ChangeUpdate, Key: "long", OldValue: []int{1, 2, 3}, NewValue: []int{1, 2}},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("CapValues() events = %+v, want %+v", got, want)
+		}
+	})
+}
+
+func TestCumulativeSum(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map yields empty map",
+			o:      New[string, int](),
+			expect: New[string, int](),
+		},
+		{
+			name:   "running totals follow insertion order",
+			o:      newFromPairs(kvp("jan", 10), kvp("feb", 5), kvp("mar", -3), kvp("apr", 8)),
+			expect: newFromPairs(kvp("jan", 10), kvp("feb", 15), kvp("mar", 12), kvp("apr", 20)),
+		},
+		{
+			name: "running totals follow manipulated order",
+			o: func() *OrderedMap[string, int] {
+				m := newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3))
+				_ = m.MoveToFront("c")
+				return m
+			}(),
+			expect: newFromPairs(kvp("c", 3), kvp("a", 4), kvp("b", 6)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := tt.o.GoString()
+			compareOrderedMaps(t, tt.expect, CumulativeSum(tt.o))
+			if tt.o.GoString() != original {
+				t.Errorf("CumulativeSum() modified the source map: %s", tt.o.GoString())
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	o := newFromPairs(kvp("a", "x"), kvp("b", "y"), kvp("c", "z"))
+	got := Scan(o, "", func(acc string, key string, value string) string {
+		return acc + key + value
+	})
+	compareOrderedMaps(t, newFromPairs(kvp("a", "ax"), kvp("b", "axby"), kvp("c", "axbycz")), got)
+}
+
+func TestMissingKeys(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[int, string]
+		from int
+		to   int
+		want []int
+	}
+	tests := []testCase{
+		{
+			name: "empty map is missing the full range",
+			o:    New[int, string](),
+			from: 1,
+			to:   3,
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "reports several gaps in ascending order regardless of map order",
+			o:    newFromPairs(kvp(9, "i"), kvp(1, "a"), kvp(4, "d"), kvp(5, "e"), kvp(2, "b")),
+			from: 1,
+			to:   10,
+			want: []int{3, 6, 7, 8, 10},
+		},
+		{
+			name: "complete sequence has no gaps",
+			o:    newFromPairs(kvp(0, "a"), kvp(1, "b"), kvp(2, "c")),
+			from: 0,
+			to:   2,
+			want: []int{},
+		},
+		{
+			name: "inverted range is empty",
+			o:    New[int, string](),
+			from: 5,
+			to:   1,
+			want: []int{},
+		},
+		{
+			name: "range ending at max int terminates",
+			o:    newFromPairs(kvp(math.MaxInt, "max")),
+			from: math.MaxInt - 2,
+			to:   math.MaxInt,
+			want: []int{math.MaxInt - 2, math.MaxInt - 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MissingKeys(tt.o, tt.from, tt.to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MissingKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildPaged(t *testing.T) {
+	errPage := errors.New("page unavailable")
+	type testCase struct {
+		name    string
+		pages   [][]KeyValuePair[string, int]
+		failAt  int
+		want    *OrderedMap[string, int]
+		wantErr error
+	}
+	tests := []testCase{
+		{
+			name:   "single empty page yields empty map",
+			pages:  [][]KeyValuePair[string, int]{{}},
+			failAt: -1,
+			want:   New[string, int](),
+		},
+		{
+			name: "multiple pages are inserted in order",
+			pages: [][]KeyValuePair[string, int]{
+				{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+				{},
+				{{Key: "c", Value: 3}},
+				{{Key: "d", Value: 4}, {Key: "e", Value: 5}},
+			},
+			failAt: -1,
+			want:   newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4), kvp("e", 5)),
+		},
+		{
+			name: "stops at first failure",
+			pages: [][]KeyValuePair[string, int]{
+				{{Key: "a", Value: 1}},
+				{{Key: "b", Value: 2}},
+				{{Key: "c", Value: 3}},
+			},
+			failAt:  1,
+			wantErr: errPage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got, err := BuildPaged(func() ([]KeyValuePair[string, int], bool, error) {
+				defer func() { calls++ }()
+				if calls == tt.failAt {
+					return nil, false, errPage
+				}
+				return tt.pages[calls], calls < len(tt.pages)-1, nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("BuildPaged() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if calls != tt.failAt+1 {
+					t.Errorf("BuildPaged() fetched %d pages, expected to stop after %d", calls, tt.failAt+1)
+				}
+				return
+			}
+			compareOrderedMaps(t, tt.want, got)
+		})
+	}
+}
+
+func TestTopN(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, int]
+		n    int
+		want []KeyValuePair[string, int]
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields empty result",
+			o:    New[string, int](),
+			n:    3,
+			want: []KeyValuePair[string, int]{},
+		},
+		{
+			name: "highest values sorted descending",
+			o:    newFromPairs(kvp("the", 10), kvp("go", 3), kvp("map", 7), kvp("a", 12)),
+			n:    2,
+			want: []KeyValuePair[string, int]{{Key: "a", Value: 12}, {Key: "the", Value: 10}},
+		},
+		{
+			name: "ties are stable by insertion order",
+			o:    newFromPairs(kvp("x", 1), kvp("y", 5), kvp("z", 5), kvp("w", 5)),
+			n:    3,
+			want: []KeyValuePair[string, int]{{Key: "y", Value: 5}, {Key: "z", Value: 5}, {Key: "w", Value: 5}},
+		},
+		{
+			name: "n larger than length is clamped",
+			o:    newFromPairs(kvp("x", 1), kvp("y", 2)),
+			n:    10,
+			want: []KeyValuePair[string, int]{{Key: "y", Value: 2}, {Key: "x", Value: 1}},
+		},
+		{
+			name: "negative n yields empty result",
+			o:    newFromPairs(kvp("x", 1)),
+			n:    -1,
+			want: []KeyValuePair[string, int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := tt.o.GoString()
+			if got := TopN(tt.o, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopN() = %v, want %v", got, tt.want)
+			}
+			if after := tt.o.GoString(); after != before {
+				t.Errorf("TopN() mutated the map: %s", after)
+			}
+		})
+	}
+}
+
+func TestIsKeyPermutation(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[int, string]
+		n    int
+		want bool
+	}
+	tests := []testCase{
+		{
+			name: "empty map is a permutation of zero",
+			o:    New[int, string](),
+			n:    0,
+			want: true,
+		},
+		{
+			name: "complete key set in any order",
+			o:    newFromPairs(kvp(2, "c"), kvp(0, "a"), kvp(3, "d"), kvp(1, "b")),
+			n:    4,
+			want: true,
+		},
+		{
+			name: "incomplete key set",
+			o:    newFromPairs(kvp(0, "a"), kvp(2, "c")),
+			n:    3,
+			want: false,
+		},
+		{
+			name: "out of range key",
+			o:    newFromPairs(kvp(0, "a"), kvp(1, "b"), kvp(3, "d")),
+			n:    3,
+			want: false,
+		},
+		{
+			name: "negative key",
+			o:    newFromPairs(kvp(-1, "z"), kvp(0, "a")),
+			n:    2,
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsKeyPermutation(tt.o, tt.n); got != tt.want {
+				t.Errorf("IsKeyPermutation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToSlice(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[int, string]
+		want   []string
+		wantOk bool
+	}
+	tests := []testCase{
+		{
+			name:   "empty map yields empty slice",
+			o:      New[int, string](),
+			want:   []string{},
+			wantOk: true,
+		},
+		{
+			name:   "dense keys are indexed by key",
+			o:      newFromPairs(kvp(2, "c"), kvp(0, "a"), kvp(1, "b")),
+			want:   []string{"a", "b", "c"},
+			wantOk: true,
+		},
+		{
+			name:   "sparse keys are rejected",
+			o:      newFromPairs(kvp(0, "a"), kvp(5, "f")),
+			want:   nil,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ToSlice(tt.o)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToSlice() got = %v, want %v", got, tt.want)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("ToSlice() ok = %v, want %v", ok, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		f      func(string, int) string
+		expect *OrderedMap[string, string]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map yields empty map",
+			o:      New[string, int](),
+			f:      func(string, int) string { return "" },
+			expect: New[string, string](),
+		},
+		{
+			name: "transforms values to a different type in order",
+			o: func() *OrderedMap[string, int] {
+				m := newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3))
+				_ = m.MoveToFront("three")
+				return m
+			}(),
+			f:      func(k string, v int) string { return k + "=" + strconv.Itoa(v) },
+			expect: newFromPairs(kvp("three", "three=3"), kvp("one", "one=1"), kvp("two", "two=2")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := tt.o.Clone()
+			compareOrderedMaps(t, tt.expect, MapValues(tt.o, tt.f))
+			compareOrderedMaps(t, original, tt.o)
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	concat := func(acc string, k string, v int) string {
+		return acc + k + strconv.Itoa(v)
+	}
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, int]
+		want string
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields initial value",
+			o:    New[string, int](),
+			want: ">",
+		},
+		{
+			name: "accumulates in map order",
+			o:    newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			want: ">a1b2c3",
+		},
+		{
+			name: "order matters",
+			o: func() *OrderedMap[string, int] {
+				m := newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3))
+				_ = m.MoveToFront("c")
+				return m
+			}(),
+			want: ">c3a1b2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reduce(tt.o, ">", concat); got != tt.want {
+				t.Errorf("Reduce() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("sum", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3))
+		if got := Reduce(o, 0, func(acc int, _ string, v int) int { return acc + v }); got != 6 {
+			t.Errorf("Reduce() = %d, want 6", got)
+		}
+	})
+}
+
+func TestGroupBy(t *testing.T) {
+	type testCase struct {
+		name       string
+		o          *OrderedMap[string, int]
+		keyFn      func(string, int) string
+		wantGroups []string
+		expect     []*OrderedMap[string, int]
+	}
+	parity := func(_ string, v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+	tests := []testCase{
+		{
+			name:       "empty map yields no groups",
+			o:          New[string, int](),
+			keyFn:      parity,
+			wantGroups: []string{},
+		},
+		{
+			name:       "nil map yields no groups",
+			o:          nil,
+			keyFn:      parity,
+			wantGroups: []string{},
+		},
+		{
+			name:       "groups in order of discovery with pairs in map order",
+			o:          newFromPairs(kvp("two", 2), kvp("one", 1), kvp("four", 4), kvp("three", 3), kvp("six", 6)),
+			keyFn:      parity,
+			wantGroups: []string{"even", "odd"},
+			expect: []*OrderedMap[string, int]{
+				newFromPairs(kvp("two", 2), kvp("four", 4), kvp("six", 6)),
+				newFromPairs(kvp("one", 1), kvp("three", 3)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := tt.o.Clone()
+			got := GroupBy(tt.o, tt.keyFn)
+			if keys := got.Keys(); !reflect.DeepEqual(keys, tt.wantGroups) {
+				t.Fatalf("GroupBy() groups = %v, want %v", keys, tt.wantGroups)
+			}
+			for i, group := range got.Values() {
+				compareOrderedMaps(t, tt.expect[i], group)
+			}
+			compareOrderedMaps(t, original, tt.o)
+		})
+	}
+}
+
+func TestMinMaxByValue(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	type testCase struct {
+		name    string
+		o       *OrderedMap[string, int]
+		wantMin *KeyValuePair[string, int]
+		wantMax *KeyValuePair[string, int]
+	}
+	tests := []testCase{
+		{
+			name: "empty map",
+			o:    New[string, int](),
+		},
+		{
+			name:    "single pair",
+			o:       newFromPairs(kvp("a", 1)),
+			wantMin: &KeyValuePair[string, int]{Key: "a", Value: 1},
+			wantMax: &KeyValuePair[string, int]{Key: "a", Value: 1},
+		},
+		{
+			name:    "distinct values",
+			o:       newFromPairs(kvp("a", 2), kvp("b", 3), kvp("c", 1)),
+			wantMin: &KeyValuePair[string, int]{Key: "c", Value: 1},
+			wantMax: &KeyValuePair[string, int]{Key: "b", Value: 3},
+		},
+		{
+			name:    "first pair wins ties",
+			o:       newFromPairs(kvp("a", 2), kvp("b", 1), kvp("c", 3), kvp("d", 1), kvp("e", 3)),
+			wantMin: &KeyValuePair[string, int]{Key: "b", Value: 1},
+			wantMax: &KeyValuePair[string, int]{Key: "c", Value: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(name string, got *KeyValuePair[string, int], ok bool, want *KeyValuePair[string, int]) {
+				t.Helper()
+				if ok != (want != nil) {
+					t.Fatalf("%s() ok = %v, want %v", name, ok, want != nil)
+				}
+				if want != nil && (got.Key != want.Key || got.Value != want.Value) {
+					t.Errorf("%s() = %v, want %v", name, got, want)
+				}
+			}
+			got, ok := MinByValue(tt.o, less)
+			check("MinByValue", got, ok, tt.wantMin)
+			got, ok = MaxByValue(tt.o, less)
+			check("MaxByValue", got, ok, tt.wantMax)
+		})
+	}
+}
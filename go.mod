@@ -1,3 +1,10 @@
 module github.com/jimschubert/ordered-map
 
-go 1.18
+go 1.23
+
+require (
+	github.com/vmihailenco/msgpack/v5 v5.4.1
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
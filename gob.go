@@ -0,0 +1,49 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// gobPairs is the wire representation of an OrderedMap, with Keys and Values sharing positions.
+type gobPairs[K comparable, V any] struct {
+	Keys   []K
+	Values []V
+}
+
+// GobEncode fulfills the gob.GobEncoder interface, encoding the pairs of the map in order.
+func (o *OrderedMap[K, V]) GobEncode() ([]byte, error) {
+	wire := gobPairs[K, V]{
+		Keys:   make([]K, 0, o.order.Len()),
+		Values: make([]V, 0, o.order.Len()),
+	}
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		wire.Keys = append(wire.Keys, e.Value.Key)
+		wire.Values = append(wire.Values, e.Value.Value)
+	}
+
+	buf := bytes.Buffer{}
+	if err := gob.NewEncoder(&buf).Encode(wire); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode fulfills the gob.GobDecoder interface, rebuilding the map in the encoded order.
+// Any existing contents of the map are cleared.
+func (o *OrderedMap[K, V]) GobDecode(data []byte) error {
+	var wire gobPairs[K, V]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&wire); err != nil {
+		return err
+	}
+
+	o.Init()
+	for i := range wire.Keys {
+		var value V
+		if i < len(wire.Values) {
+			value = wire.Values[i]
+		}
+		o.Set(wire.Keys[i], value)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+type gobPoint struct {
+	X, Y int
+}
+
+func gobRoundTrip(t *testing.T, in any, out any) {
+	t.Helper()
+	buf := bytes.Buffer{}
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("GobEncode() error = %v", err)
+	}
+	if err := gob.NewDecoder(&buf).Decode(out); err != nil {
+		t.Fatalf("GobDecode() error = %v", err)
+	}
+}
+
+func TestOrderedMap_Gob(t *testing.T) {
+	t.Run("string keys", func(t *testing.T) {
+		in := newFromPairs(kvp("zebra", 1), kvp("apple", 2), kvp("mango", 3))
+		out := New[string, int]().Set("stale", 100)
+		gobRoundTrip(t, in, out)
+		compareOrderedMaps(t, in, out)
+	})
+
+	t.Run("integer keys with struct values", func(t *testing.T) {
+		in := newFromPairs(kvp(30, gobPoint{1, 2}), kvp(-1, gobPoint{3, 4}), kvp(2, gobPoint{}))
+		var out OrderedMap[int, gobPoint]
+		gobRoundTrip(t, in, &out)
+		compareOrderedMaps(t, in, &out)
+	})
+
+	t.Run("registered concrete types behind interface values", func(t *testing.T) {
+		gob.Register(gobPoint{})
+		in := New[string, any]().Set("point", gobPoint{5, 6}).Set("name", "origin").Set("n", 42)
+		out := New[string, any]()
+		gobRoundTrip(t, in, out)
+		compareOrderedMaps(t, in, out)
+	})
+
+	t.Run("nested in a struct", func(t *testing.T) {
+		type document struct {
+			Name   string
+			Fields *OrderedMap[string, string]
+		}
+		in := document{Name: "doc", Fields: newFromPairs(kvp("b", "2"), kvp("a", "1"))}
+		var out document
+		gobRoundTrip(t, in, &out)
+		if out.Name != in.Name {
+			t.Errorf("Name = %q, want %q", out.Name, in.Name)
+		}
+		compareOrderedMaps(t, in.Fields, out.Fields)
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		in := New[string, int]()
+		out := New[string, int]()
+		gobRoundTrip(t, in, out)
+		compareOrderedMaps(t, in, out)
+	})
+}
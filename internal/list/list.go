@@ -72,6 +72,7 @@ func (e *Element[T]) Prev() *Element[T] {
 type List[T any] struct {
 	root Element[T] // sentinel list element, only &root, root.prev, and root.next are used
 	len  int        // current list length excluding (this) sentinel element
+	mods uint64     // count of structural modifications, allowing iterators to detect changes
 }
 
 // Init initializes or clears list l.
@@ -79,9 +80,14 @@ func (l *List[T]) Init() *List[T] {
 	l.root.next = &l.root
 	l.root.prev = &l.root
 	l.len = 0
+	l.mods++
 	return l
 }
 
+// Mods returns the number of structural modifications (insertions, removals, moves, and clears) made to list l.
+// The count only ever increases, so a changed count signals that l was modified.
+func (l *List[T]) Mods() uint64 { return l.mods }
+
 // New returns an initialized list.
 func New[T any]() *List[T] { return new(List[T]).Init() }
 
@@ -120,6 +126,7 @@ func (l *List[T]) insert(e, at *Element[T]) *Element[T] {
 	e.next.prev = e
 	e.list = l
 	l.len++
+	l.mods++
 	return e
 }
 
@@ -136,6 +143,7 @@ func (l *List[T]) remove(e *Element[T]) {
 	e.prev = nil // avoid memory leaks
 	e.list = nil
 	l.len--
+	l.mods++
 }
 
 // move moves e to next to at.
@@ -143,6 +151,7 @@ func (l *List[T]) move(e, at *Element[T]) {
 	if e == at {
 		return
 	}
+	l.mods++
 	e.prev.next = e.next
 	e.next.prev = e.prev
 
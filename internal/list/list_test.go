@@ -367,3 +367,32 @@ func TestMoveUnknownMark(t *testing.T) {
 	checkList(t, &l1, []int{1})
 	checkList(t, &l2, []int{2})
 }
+
+func TestMods(t *testing.T) {
+	l := New[int]()
+	mods := l.Mods()
+	checkMods := func(changed bool) {
+		t.Helper()
+		if got := l.Mods(); (got != mods) != changed {
+			t.Errorf("Mods() = %d, previously %d; want changed = %v", got, mods, changed)
+		}
+		mods = l.Mods()
+	}
+
+	e1 := l.PushBack(1)
+	checkMods(true)
+	e2 := l.PushBack(2)
+	checkMods(true)
+	l.MoveToBack(e2)
+	checkMods(false)
+	l.MoveToFront(e2)
+	checkMods(true)
+	e1.Value = 10
+	checkMods(false)
+	l.Remove(e1)
+	checkMods(true)
+	l.Remove(e1)
+	checkMods(false)
+	l.Init()
+	checkMods(true)
+}
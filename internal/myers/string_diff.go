@@ -3,7 +3,7 @@ package myers
 import (
 	"bytes"
 	"fmt"
-	"sort"
+	"strings"
 )
 
 type point struct {
@@ -29,92 +29,175 @@ func (e editList) get(idx int) int {
 	return e[idx]
 }
 
-type operation int
+// Op is the operation of a single edit within an edit script.
+type Op int
 
 const (
-	ins operation = iota
-	del
-	eql
+	// Insert an element of rhs.
+	Insert Op = iota
+	// Delete an element of lhs.
+	Delete
+	// Equal elements of lhs and rhs.
+	Equal
 )
 
 type lineDiff struct {
-	op operation
-	a  string
+	op    Op
+	a     string
+	plain bool
 }
 
 func (l lineDiff) String() string {
+	if l.plain {
+		return l.a
+	}
+
 	buf := bytes.Buffer{}
 	switch l.op {
-	case del:
+	case Delete:
 		buf.WriteString("\033[31m")
 		buf.WriteString(l.a)
 		buf.WriteString("\033[0m")
-	case ins:
+	case Insert:
 		buf.WriteString("\033[32m")
 		buf.WriteString(l.a)
 		buf.WriteString("\033[0m")
-	case eql:
+	case Equal:
 		buf.WriteString(l.a)
 	}
 
 	return buf.String()
 }
 
-func min(a, b int) int {
-	if a <= b {
-		return a
+// DiffOptions controls how Diff and DiffLines render their output.
+// The zero value colorizes output with ANSI escape codes for display in a terminal.
+type DiffOptions struct {
+	// Plain disables ANSI escape codes, for output written to logs or files.
+	// Character diffs then mark deletions as [-text-] and insertions as {+text+}.
+	Plain bool
+}
+
+// Edit is a run of consecutive text sharing the same operation within an edit script.
+type Edit struct {
+	Op   Op
+	Text string
+}
+
+// Edits returns the shortest edit script turning first into second, using Myer's Algorithm.
+// Consecutive operations of the same kind are coalesced into a single Edit.
+// Strings are compared rune by rune, so a multibyte character is always inserted or deleted as a unit.
+func Edits(first, second string) []Edit {
+	lhs := []rune(first)
+	rhs := []rune(second)
+
+	edits := make([]Edit, 0)
+	// each run of the same operation is collected as runes and converted once, avoiding quadratic concatenation
+	var run []rune
+	op := Equal
+	for _, e := range Script(lhs, rhs) {
+		if len(run) > 0 && e.Op != op {
+			edits = append(edits, Edit{Op: op, Text: string(run)})
+			run = run[:0]
+		}
+		op = e.Op
+		if e.Op == Insert {
+			run = append(run, rhs[e.Rhs])
+		} else {
+			run = append(run, lhs[e.Lhs])
+		}
 	}
-	return b
+	if len(run) > 0 {
+		edits = append(edits, Edit{Op: op, Text: string(run)})
+	}
+	return edits
 }
 
-// Diff between two strings (first, second) using Myer's Algorithm.
+// Diff between two strings (first, second) using Myer's Algorithm, colorized for display in a terminal.
+// Returns false if the strings are equal.
+//
 // Implemented based on the excellent blog at https://blog.jcoglan.com/2017/02/12/the-myers-diff-algorithm-part-1/
 // And the original paper "An O(ND) Difference Algorithm and Its Variations" by Eugene W. Myer
 // See: https://link.springer.com/article/10.1007/BF01840446
 func Diff(first, second string) (string, bool) {
-	buf := bytes.Buffer{}
+	return DiffOptions{}.Diff(first, second)
+}
 
-	lhs := []byte(first)
-	rhs := []byte(second)
-	steps, err := backtrack(lhs, rhs)
-	if err != nil {
-		return "", false
-	}
+// DiffPlain is Diff without ANSI escape codes; see DiffOptions.Plain.
+func DiffPlain(first, second string) (string, bool) {
+	return DiffOptions{Plain: true}.Diff(first, second)
+}
 
+// Diff between two strings (first, second) using Myer's Algorithm, rendered according to o.
+// Returns false if the strings are equal.
+func (o DiffOptions) Diff(first, second string) (string, bool) {
+	buf := bytes.Buffer{}
 	unequal := false
-
-	sort.SliceStable(steps, func(i, j int) bool {
-		return true
-	})
-	prevA := 0
-	for _, s := range steps {
-		// fmt.Printf("%s (prevA=%d) - ", s.String(), prevA)
-		if s.to.X == s.from.X {
+	for _, e := range Edits(first, second) {
+		text := e.Text
+		switch {
+		case e.Op == Equal:
+		case !o.Plain:
+			unequal = true
+		case e.Op == Delete:
 			unequal = true
-			// inserted, rhs
-			buf.WriteString(lineDiff{eql, string(rhs[min(prevA, s.to.Y):s.to.Y])}.String())
-			inserted := string(rhs[s.from.Y:s.to.Y])
-			buf.WriteString(lineDiff{ins, inserted}.String())
-			prevA = s.to.Y + 1
-			// fmt.Printf("INSERT: char %d (rhs)[%s]\n", s.to.Y, buf.String())
-		} else if s.to.Y == s.from.Y {
+			text = "[-" + text + "-]"
+		case e.Op == Insert:
 			unequal = true
-			// deleted, rhs
-			buf.WriteString(lineDiff{eql, string(rhs[min(prevA, s.from.X):s.from.X])}.String())
-			remove := string(lhs[s.from.X:s.to.X])
-			buf.WriteString(lineDiff{del, remove}.String())
-			prevA = s.from.X + 1
-			// fmt.Printf("DELETE: char %d (lhs)[%s]\n", s.from.X, buf.String())
+			text = "{+" + text + "+}"
+		}
+		buf.WriteString(lineDiff{e.Op, text, o.Plain}.String())
+	}
+
+	if unequal {
+		return buf.String(), true
+	}
+
+	return "", false
+}
+
+// LineEdits returns the shortest edit script turning first into second, treating each line as a single unit.
+// Unlike Edits, consecutive lines are not coalesced: each Edit holds exactly one line, without its trailing newline.
+func LineEdits(first, second string) []Edit {
+	lhs := strings.Split(first, "\n")
+	rhs := strings.Split(second, "\n")
+
+	edits := make([]Edit, 0, len(lhs))
+	for _, e := range Script(lhs, rhs) {
+		if e.Op == Insert {
+			edits = append(edits, Edit{Op: e.Op, Text: rhs[e.Rhs]})
 		} else {
-			// x and y both change
-			buf.WriteString(lineDiff{eql, string(rhs[s.from.Y:s.to.Y])}.String())
-			prevA = s.to.Y + 1
-			// fmt.Printf("EQUAL: from %d to %d (lhs)[%s]\n", s.from.X, s.to.X, buf.String())
+			edits = append(edits, Edit{Op: e.Op, Text: lhs[e.Lhs]})
 		}
 	}
+	return edits
+}
+
+// DiffLines between two strings (first, second) using Myer's Algorithm over lines rather than characters.
+// Removed lines are prefixed with "-" and added lines with "+", colorized for display in a terminal;
+// unchanged lines are prefixed with a space. Returns false if the strings are equal.
+func DiffLines(first, second string) (string, bool) {
+	return DiffOptions{}.DiffLines(first, second)
+}
 
-	if unequal && prevA < len(rhs) {
-		buf.WriteString(string(rhs[prevA:]))
+// DiffLines between two strings (first, second) using Myer's Algorithm over lines, rendered according to o.
+// Returns false if the strings are equal.
+func (o DiffOptions) DiffLines(first, second string) (string, bool) {
+	buf := bytes.Buffer{}
+	unequal := false
+	for i, e := range LineEdits(first, second) {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+		switch e.Op {
+		case Delete:
+			unequal = true
+			buf.WriteString(lineDiff{e.Op, "-" + e.Text, o.Plain}.String())
+		case Insert:
+			unequal = true
+			buf.WriteString(lineDiff{e.Op, "+" + e.Text, o.Plain}.String())
+		case Equal:
+			buf.WriteString(" " + e.Text)
+		}
 	}
 
 	if unequal {
@@ -124,7 +207,7 @@ func Diff(first, second string) (string, bool) {
 	return "", false
 }
 
-func backtrack(lhs, rhs []byte) ([]step, error) {
+func backtrack[T comparable](lhs, rhs []T) ([]step, error) {
 	edits, err := ses(lhs, rhs)
 	if err != nil {
 		return nil, err
@@ -167,8 +250,55 @@ func backtrack(lhs, rhs []byte) ([]step, error) {
 	return steps, nil
 }
 
+// IndexEdit is a single element-level operation within an edit script.
+// Lhs is the index of the element within lhs, or -1 for Insert. Rhs is the index within rhs, or -1 for Delete.
+type IndexEdit struct {
+	Op  Op
+	Lhs int
+	Rhs int
+}
+
+// Script returns the shortest edit script turning lhs into rhs, as element-level operations in order.
+func Script[T comparable](lhs, rhs []T) []IndexEdit {
+	edits := make([]IndexEdit, 0, len(lhs)+len(rhs))
+	if len(lhs) == 0 || len(rhs) == 0 {
+		for i := range lhs {
+			edits = append(edits, IndexEdit{Op: Delete, Lhs: i, Rhs: -1})
+		}
+		for i := range rhs {
+			edits = append(edits, IndexEdit{Op: Insert, Lhs: -1, Rhs: i})
+		}
+		return edits
+	}
+
+	steps, _ := backtrack(lhs, rhs)
+	// backtrack walks from the end, so traverse its steps in reverse
+	for i := len(steps) - 1; i >= 0; i-- {
+		s := steps[i]
+		switch {
+		case s.from.X == s.to.X:
+			edits = append(edits, IndexEdit{Op: Insert, Lhs: -1, Rhs: s.from.Y})
+		case s.from.Y == s.to.Y:
+			edits = append(edits, IndexEdit{Op: Delete, Lhs: s.from.X, Rhs: -1})
+		default:
+			edits = append(edits, IndexEdit{Op: Equal, Lhs: s.from.X, Rhs: s.from.Y})
+		}
+	}
+	return edits
+}
+
+// Distance returns the minimum number of insertions and deletions required to turn lhs into rhs.
+func Distance[T comparable](lhs, rhs []T) int {
+	if len(lhs) == 0 || len(rhs) == 0 {
+		return len(lhs) + len(rhs)
+	}
+	trace, _ := ses(lhs, rhs)
+	// each trace entry is a snapshot taken before exploring edit distance d
+	return len(trace) - 1
+}
+
 // ses (Shorted Edit Search) is a graph search
-func ses(lhs, rhs []byte) ([]editList, error) {
+func ses[T comparable](lhs, rhs []T) ([]editList, error) {
 	var x int
 	n := len(lhs)
 	m := len(rhs)
@@ -1,7 +1,10 @@
 package myers
 
 import (
+	"reflect"
+	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 func TestDiff(t *testing.T) {
@@ -18,7 +21,7 @@ func TestDiff(t *testing.T) {
 		{
 			name:   "example: ABCABBA -> CBABAC",
 			args:   args{first: "ABCABBA", second: "CBABAC"},
-			want:   "\033[31mA\033[0m\033[31mB\033[0mC\033[32mB\033[0mAB\033[31mB\033[0mA\033[32mC\033[0m",
+			want:   "\033[31mAB\033[0mC\033[32mB\033[0mAB\033[31mB\033[0mA\033[32mC\033[0m",
 			wantOk: true,
 		},
 		{
@@ -48,7 +51,19 @@ func TestDiff(t *testing.T) {
 		{
 			name:   "unequal strings are not equal (multi-line)",
 			args:   args{first: "anteaters\nare\nawesome", second: "anteaters\nare\nlame"},
-			want:   "anteaters\nare\n\033[32ml\033[0ma\033[31mw\033[0m\033[31me\033[0m\033[31ms\033[0m\033[31mo\033[0mme",
+			want:   "anteaters\nare\n\033[32ml\033[0ma\033[31mweso\033[0mme",
+			wantOk: true,
+		},
+		{
+			name:   "multibyte characters are diffed as a unit",
+			args:   args{first: "café", second: "cafe"},
+			want:   "caf\033[31mé\033[0m\033[32me\033[0m",
+			wantOk: true,
+		},
+		{
+			name:   "multibyte CJK characters are diffed as a unit",
+			args:   args{first: "日本語", second: "日本人"},
+			want:   "日本\033[31m語\033[0m\033[32m人\033[0m",
 			wantOk: true,
 		},
 	}
@@ -58,6 +73,9 @@ func TestDiff(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("Diff() got = %v, want %v", got, tt.want)
 			}
+			if !utf8.ValidString(got) {
+				t.Errorf("Diff() got = %q, which is not valid UTF-8", got)
+			}
 			if ok {
 				t.Logf("Diff: %s", got)
 			}
@@ -67,3 +85,246 @@ func TestDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffOptions_Plain(t *testing.T) {
+	tests := []struct {
+		name      string
+		first     string
+		second    string
+		wantDiff  string
+		wantLines string
+		wantOk    bool
+	}{
+		{
+			name:      "equal strings are equal",
+			first:     "anteater",
+			second:    "anteater",
+			wantDiff:  "",
+			wantLines: "",
+			wantOk:    false,
+		},
+		{
+			name:      "example: ABCABBA -> CBABAC",
+			first:     "ABCABBA",
+			second:    "CBABAC",
+			wantDiff:  "[-AB-]C{+B+}AB[-B-]A{+C+}",
+			wantLines: "-ABCABBA\n+CBABAC",
+			wantOk:    true,
+		},
+		{
+			name:      "multi-line",
+			first:     "anteaters\nare\nawesome",
+			second:    "anteaters\nare\nlame",
+			wantDiff:  "anteaters\nare\n{+l+}a[-weso-]me",
+			wantLines: " anteaters\n are\n-awesome\n+lame",
+			wantOk:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DiffOptions{Plain: true}
+			got, ok := opts.Diff(tt.first, tt.second)
+			if got != tt.wantDiff {
+				t.Errorf("Diff() got = %q, want %q", got, tt.wantDiff)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("Diff() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if strings.Contains(got, "\033") {
+				t.Errorf("Diff() got = %q, which contains escape codes", got)
+			}
+
+			got, ok = opts.DiffLines(tt.first, tt.second)
+			if got != tt.wantLines {
+				t.Errorf("DiffLines() got = %q, want %q", got, tt.wantLines)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("DiffLines() ok = %v, want %v", ok, tt.wantOk)
+			}
+
+			if got, _ := DiffPlain(tt.first, tt.second); got != tt.wantDiff {
+				t.Errorf("DiffPlain() got = %q, want %q", got, tt.wantDiff)
+			}
+		})
+	}
+}
+
+func TestEdits(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  string
+		second string
+		want   []Edit
+	}{
+		{
+			name:   "empty strings",
+			first:  "",
+			second: "",
+			want:   []Edit{},
+		},
+		{
+			name:   "equal strings",
+			first:  "anteater",
+			second: "anteater",
+			want:   []Edit{{Op: Equal, Text: "anteater"}},
+		},
+		{
+			name:   "insert only",
+			first:  "",
+			second: "abc",
+			want:   []Edit{{Op: Insert, Text: "abc"}},
+		},
+		{
+			name:   "delete only",
+			first:  "abc",
+			second: "",
+			want:   []Edit{{Op: Delete, Text: "abc"}},
+		},
+		{
+			name:   "example: ABCABBA -> CBABAC",
+			first:  "ABCABBA",
+			second: "CBABAC",
+			want: []Edit{
+				{Op: Delete, Text: "AB"},
+				{Op: Equal, Text: "C"},
+				{Op: Insert, Text: "B"},
+				{Op: Equal, Text: "AB"},
+				{Op: Delete, Text: "B"},
+				{Op: Equal, Text: "A"},
+				{Op: Insert, Text: "C"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Edits(tt.first, tt.second); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Edits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  string
+		second string
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "equal strings are equal",
+			first:  "anteaters\nare\nawesome",
+			second: "anteaters\nare\nawesome",
+			want:   "",
+			wantOk: false,
+		},
+		{
+			name:   "changed line is removed and added",
+			first:  "anteaters\nare\nawesome",
+			second: "anteaters\nare\nlame",
+			want:   " anteaters\n are\n\033[31m-awesome\033[0m\n\033[32m+lame\033[0m",
+			wantOk: true,
+		},
+		{
+			name:   "added line",
+			first:  "a\nc",
+			second: "a\nb\nc",
+			want:   " a\n\033[32m+b\033[0m\n c",
+			wantOk: true,
+		},
+		{
+			name:   "removed line",
+			first:  "a\nb\nc",
+			second: "a\nc",
+			want:   " a\n\033[31m-b\033[0m\n c",
+			wantOk: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DiffLines(tt.first, tt.second)
+			if got != tt.want {
+				t.Errorf("DiffLines() got = %q, want %q", got, tt.want)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("DiffLines() ok = %v, want %v", ok, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		lhs  []string
+		rhs  []string
+		want int
+	}{
+		{name: "both empty", lhs: []string{}, rhs: []string{}, want: 0},
+		{name: "one empty", lhs: []string{"a", "b"}, rhs: []string{}, want: 2},
+		{name: "identical", lhs: []string{"a", "b", "c"}, rhs: []string{"a", "b", "c"}, want: 0},
+		{name: "swap is one deletion and one insertion", lhs: []string{"a", "b"}, rhs: []string{"b", "a"}, want: 2},
+		{name: "example: ABCABBA -> CBABAC", lhs: strings.Split("ABCABBA", ""), rhs: strings.Split("CBABAC", ""), want: 5},
+		{name: "disjoint", lhs: []string{"a", "b"}, rhs: []string{"c", "d", "e"}, want: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Distance(tt.lhs, tt.rhs); got != tt.want {
+				t.Errorf("Distance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScript(t *testing.T) {
+	tests := []struct {
+		name string
+		lhs  []string
+		rhs  []string
+		want []IndexEdit
+	}{
+		{name: "both empty", lhs: nil, rhs: nil, want: []IndexEdit{}},
+		{
+			name: "lhs empty",
+			lhs:  nil,
+			rhs:  []string{"a", "b"},
+			want: []IndexEdit{{Op: Insert, Lhs: -1, Rhs: 0}, {Op: Insert, Lhs: -1, Rhs: 1}},
+		},
+		{
+			name: "rhs empty",
+			lhs:  []string{"a"},
+			rhs:  nil,
+			want: []IndexEdit{{Op: Delete, Lhs: 0, Rhs: -1}},
+		},
+		{
+			name: "identical",
+			lhs:  []string{"a", "b"},
+			rhs:  []string{"a", "b"},
+			want: []IndexEdit{{Op: Equal, Lhs: 0, Rhs: 0}, {Op: Equal, Lhs: 1, Rhs: 1}},
+		},
+		{
+			name: "example: ABCABBA -> CBABAC",
+			lhs:  strings.Split("ABCABBA", ""),
+			rhs:  strings.Split("CBABAC", ""),
+			want: []IndexEdit{
+				{Op: Delete, Lhs: 0, Rhs: -1},
+				{Op: Delete, Lhs: 1, Rhs: -1},
+				{Op: Equal, Lhs: 2, Rhs: 0},
+				{Op: Insert, Lhs: -1, Rhs: 1},
+				{Op: Equal, Lhs: 3, Rhs: 2},
+				{Op: Equal, Lhs: 4, Rhs: 3},
+				{Op: Delete, Lhs: 5, Rhs: -1},
+				{Op: Equal, Lhs: 6, Rhs: 4},
+				{Op: Insert, Lhs: -1, Rhs: 5},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Script(tt.lhs, tt.rhs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Script() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Package nested detects OrderedMap values of any type parameters, for encoders which live outside the core package
+// and so cannot name every instantiation of OrderedMap.
+package nested
+
+import (
+	"reflect"
+	"strings"
+)
+
+// orderedMapPkgPath is the import path of the package declaring OrderedMap.
+const orderedMapPkgPath = "github.com/jimschubert/ordered-map"
+
+// Pairs reports whether v is an *OrderedMap of any type parameters. If so, it returns the keys and values of the map
+// in order, and whether the map is nil.
+func Pairs(v any) (keys, values []any, isNil, ok bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer {
+		return nil, nil, false, false
+	}
+	t := rv.Type().Elem()
+	if t.PkgPath() != orderedMapPkgPath || !strings.HasPrefix(t.Name(), "OrderedMap[") {
+		return nil, nil, false, false
+	}
+	if rv.IsNil() {
+		return nil, nil, true, true
+	}
+
+	return toAny(rv.MethodByName("Keys").Call(nil)[0]), toAny(rv.MethodByName("Values").Call(nil)[0]), false, true
+}
+
+func toAny(slice reflect.Value) []any {
+	out := make([]any, slice.Len())
+	for i := range out {
+		out[i] = slice.Index(i).Interface()
+	}
+	return out
+}
@@ -0,0 +1,68 @@
+package nested_test
+
+import (
+	"reflect"
+	"testing"
+
+	orderedmap "github.com/jimschubert/ordered-map"
+	"github.com/jimschubert/ordered-map/internal/nested"
+)
+
+type wrapper struct {
+	*orderedmap.OrderedMap[string, int]
+}
+
+func TestPairs(t *testing.T) {
+	tests := []struct {
+		name       string
+		v          any
+		wantKeys   []any
+		wantValues []any
+		wantNil    bool
+		wantOk     bool
+	}{
+		{
+			name:       "ordered map",
+			v:          orderedmap.New[string, int]().Set("b", 2).Set("a", 1),
+			wantKeys:   []any{"b", "a"},
+			wantValues: []any{2, 1},
+			wantOk:     true,
+		},
+		{
+			name:       "ordered map of other type parameters",
+			v:          orderedmap.New[int, any]().Set(1, "x"),
+			wantKeys:   []any{1},
+			wantValues: []any{"x"},
+			wantOk:     true,
+		},
+		{
+			name:    "nil ordered map",
+			v:       (*orderedmap.OrderedMap[string, int])(nil),
+			wantNil: true,
+			wantOk:  true,
+		},
+		{
+			name: "type embedding an ordered map",
+			v:    &wrapper{orderedmap.New[string, int]()},
+		},
+		{
+			name: "other values",
+			v:    map[string]int{"a": 1},
+		},
+		{
+			name: "nil",
+			v:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, values, isNil, ok := nested.Pairs(tt.v)
+			if ok != tt.wantOk || isNil != tt.wantNil {
+				t.Fatalf("Pairs() isNil = %v, ok = %v, want %v, %v", isNil, ok, tt.wantNil, tt.wantOk)
+			}
+			if !reflect.DeepEqual(keys, tt.wantKeys) || !reflect.DeepEqual(values, tt.wantValues) {
+				t.Errorf("Pairs() = %v, %v, want %v, %v", keys, values, tt.wantKeys, tt.wantValues)
+			}
+		})
+	}
+}
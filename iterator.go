@@ -2,21 +2,88 @@ package orderedmap
 
 import "github.com/jimschubert/ordered-map/internal/list"
 
-// Iterator allows iteration of an OrderedMap
+// errConcurrentModification is the panic message raised when a map is modified during iteration.
+const errConcurrentModification = "orderedmap: concurrent map modification during iteration"
+
+// Iterator allows iteration of an OrderedMap.
+//
+// By default, Next panics if the map's order was changed other than via Remove since the iterator was created or
+// last Reset; see AllowModification. This check is best-effort and is no substitute for synchronization.
 type Iterator[K comparable, V any] struct {
 	orderedMap *OrderedMap[K, V]
 	pos        *list.Element[*KeyValuePair[K, V]]
+	reverse    bool
+	// last is the pair most recently returned by Next, which may be removed via Remove
+	last *KeyValuePair[K, V]
+	// mods is the map's modification count expected by the iterator
+	mods uint64
+	// lenient disables detection of modifications during iteration
+	lenient bool
+}
+
+// AllowModification disables the concurrent modification check, allowing the map to be modified while iterating.
+// Returns the iterator, so it may be chained with Iterator or ReverseIterator.
+func (i *Iterator[K, V]) AllowModification() *Iterator[K, V] {
+	i.lenient = true
+	return i
 }
 
-// Next returns the next KeyValuePair, or nil if there are no more items
+// checkModification panics if the map has been modified since the iterator last observed it.
+func (i *Iterator[K, V]) checkModification() {
+	if !i.lenient && i.orderedMap.order.Mods() != i.mods {
+		panic(errConcurrentModification)
+	}
+}
+
+// Next returns the next KeyValuePair, or nil if there are no more items.
+// For an iterator created via ReverseIterator, the next item is the previous pair in map order.
 func (i *Iterator[K, V]) Next() *KeyValuePair[K, V] {
+	i.last = nil
 	if i.pos == nil {
 		return nil
 	}
+	i.checkModification()
 	var value *KeyValuePair[K, V]
 	if i.pos.Value != nil {
 		value = i.pos.Value
-		i.pos = i.pos.Next()
+		if i.reverse {
+			i.pos = i.pos.Prev()
+		} else {
+			i.pos = i.pos.Next()
+		}
 	}
+	i.last = value
 	return value
 }
+
+// Remove removes the pair most recently returned by Next from the map, leaving the iterator positioned to continue
+// with the following pair.
+//
+// Only the most recently returned pair may be removed, and at most once per call to Next. Remove is a no-op if Next
+// has not been called, if Next returned nil, or if that pair has already been removed from the map.
+func (i *Iterator[K, V]) Remove() {
+	last := i.last
+	i.last = nil
+	if last == nil {
+		return
+	}
+	i.checkModification()
+	// Next has already advanced past last, so unlinking it leaves the cursor intact
+	if current, ok := i.orderedMap.items[last.Key]; ok && current == last {
+		i.orderedMap.removeKeyValuePair(last)
+		i.mods = i.orderedMap.order.Mods()
+	}
+}
+
+// Reset repositions the iterator at the start of the map, reflecting any mutations since the iterator was created.
+// This also accepts those mutations for the purpose of concurrent modification detection.
+// For an iterator created via ReverseIterator, the start is the last pair in map order.
+func (i *Iterator[K, V]) Reset() {
+	i.last = nil
+	i.mods = i.orderedMap.order.Mods()
+	if i.reverse {
+		i.pos = i.orderedMap.order.Back()
+	} else {
+		i.pos = i.orderedMap.order.Front()
+	}
+}
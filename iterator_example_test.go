@@ -52,3 +52,19 @@ func ExampleIterator_alternate() {
 	// The Snake says "Ssss".
 	// The Fox says "Ring-ding-ding-ding-dingeringeding!".
 }
+
+func ExampleOrderedMap_All() {
+	var animalSounds = orderedmap.New[string, string]().
+		Set("Cat", "Meow").
+		Set("Dog", "Woof").
+		Set("Cow", "Moo")
+
+	for animal, sound := range animalSounds.All() {
+		fmt.Printf("The %s says %q.\n", animal, sound)
+	}
+
+	// Output:
+	// The Cat says "Meow".
+	// The Dog says "Woof".
+	// The Cow says "Moo".
+}
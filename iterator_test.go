@@ -0,0 +1,203 @@
+package orderedmap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func collectKeys[K comparable, V any](it *Iterator[K, V]) []K {
+	keys := make([]K, 0)
+	for i := it.Next(); i != nil; i = it.Next() {
+		keys = append(keys, i.Key)
+	}
+	return keys
+}
+
+func TestIterator_Reset(t *testing.T) {
+	type testCase struct {
+		name     string
+		o        *OrderedMap[string, int]
+		iterator func(o *OrderedMap[string, int]) *Iterator[string, int]
+		manip    func(o *OrderedMap[string, int])
+		want     []string
+	}
+	tests := []testCase{
+		{
+			name:     "empty map",
+			o:        New[string, int](),
+			iterator: (*OrderedMap[string, int]).Iterator,
+			want:     []string{},
+		},
+		{
+			name:     "restarts from the front",
+			o:        newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			iterator: (*OrderedMap[string, int]).Iterator,
+			want:     []string{"a", "b", "c"},
+		},
+		{
+			name:     "restarts a reverse iterator from the back",
+			o:        newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			iterator: (*OrderedMap[string, int]).ReverseIterator,
+			want:     []string{"c", "b", "a"},
+		},
+		{
+			name:     "reflects mutations since the first pass",
+			o:        newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			iterator: (*OrderedMap[string, int]).Iterator,
+			manip: func(o *OrderedMap[string, int]) {
+				o.Remove("a")
+				o.Set("d", 4)
+				_ = o.MoveToFront("c")
+			},
+			want: []string{"c", "b", "d"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := tt.iterator(tt.o)
+			_ = collectKeys(it)
+			if tt.manip != nil {
+				tt.manip(tt.o)
+			}
+
+			it.Reset()
+			if got := collectKeys(it); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Reset() then Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("reset part way through", func(t *testing.T) {
+		it := newFromPairs(kvp("a", 1), kvp("b", 2)).Iterator()
+		it.Next()
+		it.Reset()
+		if got := collectKeys(it); !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Errorf("Reset() then Next() = %v, want [a b]", got)
+		}
+	})
+}
+
+func TestIterator_Remove(t *testing.T) {
+	type testCase struct {
+		name     string
+		o        *OrderedMap[string, int]
+		iterator func(o *OrderedMap[string, int]) *Iterator[string, int]
+		remove   func(string, int) bool
+		wantSeen []string
+		expect   *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:     "removes matching pairs including first and last",
+			o:        newFromPairs(kvp("a", -1), kvp("b", 2), kvp("c", -3), kvp("d", 4), kvp("e", -5)),
+			iterator: (*OrderedMap[string, int]).Iterator,
+			remove:   func(_ string, v int) bool { return v < 0 },
+			wantSeen: []string{"a", "b", "c", "d", "e"},
+			expect:   newFromPairs(kvp("b", 2), kvp("d", 4)),
+		},
+		{
+			name:     "removes everything",
+			o:        newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			iterator: (*OrderedMap[string, int]).Iterator,
+			remove:   func(string, int) bool { return true },
+			wantSeen: []string{"a", "b", "c"},
+			expect:   New[string, int](),
+		},
+		{
+			name:     "removes during reverse iteration",
+			o:        newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			iterator: (*OrderedMap[string, int]).ReverseIterator,
+			remove:   func(k string, _ int) bool { return k != "b" },
+			wantSeen: []string{"c", "b", "a"},
+			expect:   newFromPairs(kvp("b", 2)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := make([]string, 0)
+			it := tt.iterator(tt.o)
+			for i := it.Next(); i != nil; i = it.Next() {
+				seen = append(seen, i.Key)
+				if tt.remove(i.Key, i.Value) {
+					it.Remove()
+				}
+			}
+			if !reflect.DeepEqual(seen, tt.wantSeen) {
+				t.Errorf("Next() visited %v, want %v", seen, tt.wantSeen)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+
+	t.Run("no-op without a returned pair", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1))
+		it := o.Iterator()
+		it.Remove()
+		it.Next()
+		it.Next()
+		it.Remove()
+		compareOrderedMaps(t, newFromPairs(kvp("a", 1)), o)
+	})
+
+	t.Run("only the most recent pair, once", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1), kvp("b", 2))
+		it := o.Iterator()
+		it.Next()
+		it.Remove()
+		it.Remove()
+		compareOrderedMaps(t, newFromPairs(kvp("b", 2)), o)
+	})
+
+	t.Run("does not remove a re-inserted key", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1), kvp("b", 2))
+		it := o.Iterator().AllowModification()
+		it.Next()
+		o.Remove("a")
+		o.Set("a", 10)
+		it.Remove()
+		compareOrderedMaps(t, newFromPairs(kvp("b", 2), kvp("a", 10)), o)
+	})
+}
+
+func TestIterator_ConcurrentModification(t *testing.T) {
+	tests := []struct {
+		name      string
+		iterator  func(o *OrderedMap[string, int]) *Iterator[string, int]
+		modify    func(o *OrderedMap[string, int])
+		wantPanic bool
+	}{
+		{"set new key", (*OrderedMap[string, int]).Iterator, func(o *OrderedMap[string, int]) { o.Set("c", 3) }, true},
+		{"remove key", (*OrderedMap[string, int]).Iterator, func(o *OrderedMap[string, int]) { o.Remove("b") }, true},
+		{"move key", (*OrderedMap[string, int]).ReverseIterator, func(o *OrderedMap[string, int]) { _ = o.MoveToFront("b") }, true},
+		{"update existing value", (*OrderedMap[string, int]).Iterator, func(o *OrderedMap[string, int]) { o.Set("b", 20) }, false},
+		{"allowed modification", func(o *OrderedMap[string, int]) *Iterator[string, int] {
+			return o.Iterator().AllowModification()
+		}, func(o *OrderedMap[string, int]) { o.Set("c", 3) }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newFromPairs(kvp("a", 1), kvp("b", 2))
+			it := tt.iterator(o)
+			it.Next()
+			tt.modify(o)
+
+			defer func() {
+				r := recover()
+				if (r != nil) != tt.wantPanic {
+					t.Errorf("Next() panic = %v, wantPanic %v", r, tt.wantPanic)
+				}
+			}()
+			it.Next()
+		})
+	}
+
+	t.Run("reset accepts modifications", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1), kvp("b", 2))
+		it := o.Iterator()
+		o.Set("c", 3)
+		it.Reset()
+		if got := collectKeys(it); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+			t.Errorf("collectKeys() = %v, want [a b c]", got)
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package orderedmap
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// MarshalJSON fulfills the json.Marshaler interface, emitting a JSON object with keys in map order.
+//
+// String keys are emitted as-is, while keys of other types are formatted via fmt.Sprintf("%v", key).
+// Values are marshaled via encoding/json, so nested OrderedMap values also retain their order.
+// A nil map is emitted as null.
+func (o *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	if o == nil {
+		return []byte("null"), nil
+	}
+
+	buf := bytes.Buffer{}
+	buf.WriteByte('{')
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		key, err := json.Marshal(jsonKey(e.Value.Key))
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(e.Value.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+		if e.Next() != nil {
+			buf.WriteByte(',')
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// EncodeJSON streams the map to w as a JSON object with keys in map order, producing output identical to MarshalJSON.
+//
+// Unlike MarshalJSON, the object is not buffered in memory as a whole: each value is marshaled and written in turn,
+// and nested OrderedMap values are streamed recursively. A nil map is written as null.
+func (o *OrderedMap[K, V]) EncodeJSON(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if err := o.encodeJSON(bw); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// jsonStreamer is fulfilled by every instantiation of OrderedMap, allowing nested maps of any type to be streamed.
+//
+// Types which embed *OrderedMap also satisfy this interface via the promoted method, so streamJSON reports whether v
+// was exactly an *OrderedMap[K, V] and therefore handled. Other types may define their own MarshalJSON.
+type jsonStreamer interface {
+	streamJSON(v any, w *bufio.Writer) (handled bool, err error)
+}
+
+func (o *OrderedMap[K, V]) streamJSON(v any, w *bufio.Writer) (bool, error) {
+	m, ok := v.(*OrderedMap[K, V])
+	if !ok {
+		return false, nil
+	}
+	return true, m.encodeJSON(w)
+}
+
+func (o *OrderedMap[K, V]) encodeJSON(w *bufio.Writer) error {
+	if o == nil {
+		_, err := w.WriteString("null")
+		return err
+	}
+
+	if err := w.WriteByte('{'); err != nil {
+		return err
+	}
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		key, err := json.Marshal(jsonKey(e.Value.Key))
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(key); err != nil {
+			return err
+		}
+		if err := w.WriteByte(':'); err != nil {
+			return err
+		}
+
+		handled := false
+		if nested, ok := any(e.Value.Value).(jsonStreamer); ok {
+			handled, err = nested.streamJSON(e.Value.Value, w)
+		}
+		if !handled && err == nil {
+			var value []byte
+			if value, err = json.Marshal(e.Value.Value); err == nil {
+				_, err = w.Write(value)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if e.Next() != nil {
+			if err := w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+	}
+	return w.WriteByte('}')
+}
+
+func jsonKey[K comparable](key K) string {
+	if s, ok := any(key).(string); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", key)
+}
+
+// UnmarshalJSON fulfills the json.Unmarshaler interface, reading a JSON object and calling Set for each key in the
+// order it appears in data.
+//
+// Duplicate keys follow Set semantics: the last value wins, but the key retains the position of its first appearance.
+// Like unmarshaling into a built-in map, existing entries of o are retained. A JSON null leaves o unmodified.
+//
+// Keys are decoded into K as JSON strings where possible (e.g. string types), otherwise from the raw key text so that
+// numeric keys written by MarshalJSON round-trip. Values are decoded via encoding/json; note that a V of any decodes
+// nested objects as map[string]any, which does not retain order.
+func (o *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("orderedmap: cannot unmarshal %v into OrderedMap, expected JSON object", tok)
+	}
+
+	if o.items == nil {
+		o.Init()
+	}
+	return o.decodeJSONObject(dec)
+}
+
+// DecodeJSON reads a JSON object from r, building an OrderedMap with keys set in the order they appear.
+//
+// The object is read token by token, so that r is never buffered in memory as a whole. Values are decoded as
+// described by UnmarshalJSON. Returns an error if r does not contain a well-formed JSON object.
+func DecodeJSON[V any](r io.Reader) (*OrderedMap[string, V], error) {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("orderedmap: cannot decode JSON object: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("orderedmap: cannot decode %v into OrderedMap, expected JSON object", tok)
+	}
+
+	o := New[string, V]()
+	if err := o.decodeJSONObject(dec); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// decodeJSONObject reads the members of a JSON object whose opening delimiter has already been consumed from dec,
+// through to its closing delimiter.
+func (o *OrderedMap[K, V]) decodeJSONObject(dec *json.Decoder) error {
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		rawKey, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("orderedmap: unexpected object key %v", tok)
+		}
+		key, err := parseJSONKey[K](rawKey)
+		if err != nil {
+			return err
+		}
+
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		o.Set(key, value)
+	}
+
+	// consume the closing delimiter
+	_, err := dec.Token()
+	return err
+}
+
+func parseJSONKey[K comparable](rawKey string) (K, error) {
+	var key K
+	quoted, err := json.Marshal(rawKey)
+	if err != nil {
+		return key, err
+	}
+	if err := json.Unmarshal(quoted, &key); err == nil {
+		return key, nil
+	}
+	if err := json.Unmarshal([]byte(rawKey), &key); err != nil {
+		return key, fmt.Errorf("orderedmap: cannot unmarshal object key %q into %T: %w", rawKey, key, err)
+	}
+	return key, nil
+}
@@ -0,0 +1,257 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOrderedMap_MarshalJSON(t *testing.T) {
+	type document struct {
+		Name   string                      `json:"name"`
+		Fields *OrderedMap[string, any]    `json:"fields"`
+		Empty  *OrderedMap[string, string] `json:"empty"`
+	}
+	type testCase struct {
+		name  string
+		input any
+		want  string
+	}
+	tests := []testCase{
+		{
+			name:  "nil map emits null",
+			input: (*OrderedMap[string, int])(nil),
+			want:  `null`,
+		},
+		{
+			name:  "empty map emits empty object",
+			input: New[string, int](),
+			want:  `{}`,
+		},
+		{
+			name:  "string keys are emitted in map order",
+			input: newFromPairs(kvp("zebra", 1), kvp("apple", 2), kvp("quote\"d", 3)),
+			want:  `{"zebra":1,"apple":2,"quote\"d":3}`,
+		},
+		{
+			name:  "int keys are formatted as object keys",
+			input: newFromPairs(kvp(30, "c"), kvp(-1, "a"), kvp(2, "b")),
+			want:  `{"30":"c","-1":"a","2":"b"}`,
+		},
+		{
+			name: "nested maps retain order",
+			input: document{
+				Name: "config",
+				Fields: New[string, any]().
+					Set("z", New[string, int]().Set("b", 2).Set("a", 1)).
+					Set("a", []int{1, 2}),
+			},
+			want: `{"name":"config","fields":{"z":{"b":2,"a":1},"a":[1,2]},"empty":null}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("nil receiver emits null", func(t *testing.T) {
+		var o *OrderedMap[string, int]
+		got, err := o.MarshalJSON()
+		if err != nil || string(got) != "null" {
+			t.Errorf("MarshalJSON() = %s, %v, want null", got, err)
+		}
+	})
+
+	t.Run("value errors are surfaced", func(t *testing.T) {
+		if _, err := json.Marshal(newFromPairs(kvp("ch", make(chan int)))); err == nil {
+			t.Errorf("MarshalJSON() error = nil, want error")
+		}
+	})
+}
+
+// customJSON embeds an OrderedMap but overrides its JSON encoding.
+type customJSON struct {
+	*OrderedMap[string, int]
+}
+
+func (customJSON) MarshalJSON() ([]byte, error) {
+	return []byte(`"custom"`), nil
+}
+
+func TestOrderedMap_EncodeJSON(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, any]
+		want string
+	}
+	tests := []testCase{
+		{
+			name: "nil map emits null",
+			o:    nil,
+			want: `null`,
+		},
+		{
+			name: "empty map emits empty object",
+			o:    New[string, any](),
+			want: `{}`,
+		},
+		{
+			name: "keys are emitted in map order with html escaping",
+			o:    New[string, any]().Set("zebra", 1).Set("<apple>", "a&b").Set("quote\"d", []int{1, 2}),
+			want: `{"zebra":1,"\u003capple\u003e":"a\u0026b","quote\"d":[1,2]}`,
+		},
+		{
+			name: "nested maps are streamed in order",
+			o: New[string, any]().
+				Set("z", New[int, any]().Set(2, New[string, bool]().Set("y", true).Set("x", false)).Set(1, nil)).
+				Set("nil", (*OrderedMap[string, int])(nil)).
+				Set("a", map[string]int{"b": 2, "a": 1}),
+			want: `{"z":{"2":{"y":true,"x":false},"1":null},"nil":null,"a":{"a":1,"b":2}}`,
+		},
+		{
+			name: "values embedding a map use their own MarshalJSON",
+			o:    New[string, any]().Set("c", customJSON{New[string, int]().Set("a", 1)}),
+			want: `{"c":"custom"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			if err := tt.o.EncodeJSON(&buf); err != nil {
+				t.Fatalf("EncodeJSON() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("EncodeJSON() = %s, want %s", got, tt.want)
+			}
+
+			marshaled, err := tt.o.MarshalJSON()
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if !bytes.Equal(buf.Bytes(), marshaled) {
+				t.Errorf("EncodeJSON() = %s, which differs from MarshalJSON() = %s", buf.Bytes(), marshaled)
+			}
+		})
+	}
+
+	t.Run("value errors are surfaced", func(t *testing.T) {
+		if err := newFromPairs(kvp("ch", make(chan int))).EncodeJSON(io.Discard); err == nil {
+			t.Errorf("EncodeJSON() error = nil, want error")
+		}
+	})
+
+	t.Run("writer errors are surfaced", func(t *testing.T) {
+		if err := newFromPairs(kvp("a", 1)).EncodeJSON(failingWriter{}); err == nil {
+			t.Errorf("EncodeJSON() error = nil, want error")
+		}
+	})
+}
+
+func TestOrderedMap_UnmarshalJSON(t *testing.T) {
+	t.Run("keys follow source order", func(t *testing.T) {
+		source := `{"zebra": 1, "apple": 2, "mango": 3, "banana": 4}`
+		got := New[string, int]()
+		if err := json.Unmarshal([]byte(source), got); err != nil {
+			t.Fatalf("UnmarshalJSON() error = %v", err)
+		}
+		if keys := got.Keys(); !reflect.DeepEqual(keys, []string{"zebra", "apple", "mango", "banana"}) {
+			t.Errorf("Keys() = %v, want source order", keys)
+		}
+	})
+
+	t.Run("round trips a document", func(t *testing.T) {
+		type document struct {
+			Name   string                                        `json:"name"`
+			Fields *OrderedMap[string, *OrderedMap[string, int]] `json:"fields"`
+		}
+		source := `{"name":"config","fields":{"z":{"b":2,"a":1},"y":{},"x":{"c":3}}}`
+
+		var doc document
+		if err := json.Unmarshal([]byte(source), &doc); err != nil {
+			t.Fatalf("UnmarshalJSON() error = %v", err)
+		}
+		if keys := doc.Fields.Keys(); !reflect.DeepEqual(keys, []string{"z", "y", "x"}) {
+			t.Errorf("Keys() = %v, want source order", keys)
+		}
+		if inner, _ := doc.Fields.Get("z"); !reflect.DeepEqual((*inner).Keys(), []string{"b", "a"}) {
+			t.Errorf("nested Keys() = %v, want source order", (*inner).Keys())
+		}
+
+		out, err := json.Marshal(doc)
+		if err != nil {
+			t.Fatalf("MarshalJSON() error = %v", err)
+		}
+		if string(out) != source {
+			t.Errorf("round trip = %s, want %s", out, source)
+		}
+	})
+
+	t.Run("duplicate keys keep first position and last value", func(t *testing.T) {
+		got := New[string, int]()
+		if err := json.Unmarshal([]byte(`{"a":1,"b":2,"a":3}`), got); err != nil {
+			t.Fatalf("UnmarshalJSON() error = %v", err)
+		}
+		compareOrderedMaps(t, newFromPairs(kvp("a", 3), kvp("b", 2)), got)
+	})
+
+	t.Run("zero value map and integer keys", func(t *testing.T) {
+		var got OrderedMap[int, string]
+		if err := json.Unmarshal([]byte(`{"30":"c","-1":"a","2":"b"}`), &got); err != nil {
+			t.Fatalf("UnmarshalJSON() error = %v", err)
+		}
+		compareOrderedMaps(t, newFromPairs(kvp(30, "c"), kvp(-1, "a"), kvp(2, "b")), &got)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, source := range []string{`[1, 2]`, `{"a": "not an int"}`, `"text"`} {
+			if err := json.Unmarshal([]byte(source), New[string, int]()); err == nil {
+				t.Errorf("UnmarshalJSON(%s) error = nil, want error", source)
+			}
+		}
+		if err := json.Unmarshal([]byte(`{"x": 1}`), New[int, int]()); err == nil {
+			t.Errorf("UnmarshalJSON() error = nil, want error for non-integer key")
+		}
+	})
+}
+
+func TestDecodeJSON(t *testing.T) {
+	t.Run("keys follow source order", func(t *testing.T) {
+		source := `{"zebra": [1], "apple": [2, 3], "mango": []} trailing values are not read`
+		got, err := DecodeJSON[[]int](strings.NewReader(source))
+		if err != nil {
+			t.Fatalf("DecodeJSON() error = %v", err)
+		}
+		compareOrderedMaps(t, newFromPairs(kvp("zebra", []int{1}), kvp("apple", []int{2, 3}), kvp("mango", []int{})), got)
+	})
+
+	t.Run("nested maps retain order", func(t *testing.T) {
+		got, err := DecodeJSON[*OrderedMap[string, int]](strings.NewReader(`{"z":{"b":2,"a":1},"y":{}}`))
+		if err != nil {
+			t.Fatalf("DecodeJSON() error = %v", err)
+		}
+		if keys := got.Keys(); !reflect.DeepEqual(keys, []string{"z", "y"}) {
+			t.Errorf("Keys() = %v, want source order", keys)
+		}
+		if inner, _ := got.Get("z"); !reflect.DeepEqual((*inner).Keys(), []string{"b", "a"}) {
+			t.Errorf("nested Keys() = %v, want source order", (*inner).Keys())
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, source := range []string{``, `null`, `[1, 2]`, `"text"`, `{"a": 1`, `{"a": "not an int"}`, `{"a" 1}`} {
+			if got, err := DecodeJSON[int](strings.NewReader(source)); err == nil {
+				t.Errorf("DecodeJSON(%s) = %v, want error", source, got)
+			}
+		}
+	})
+}
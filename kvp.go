@@ -13,7 +13,19 @@ type KeyValuePair[K comparable, V any] struct {
 	element *list.Element[*KeyValuePair[K, V]]
 }
 
+// Pair constructs a KeyValuePair of key and value, for use with Of and SetMany.
+func Pair[K comparable, V any](key K, value V) KeyValuePair[K, V] {
+	return KeyValuePair[K, V]{Key: key, Value: value}
+}
+
 // String representation of this KeyValuePair
 func (k *KeyValuePair[K, V]) String() string {
 	return fmt.Sprintf("%v=%+v", k.Key, k.Value)
 }
+
+// GoString fulfills the fmt.GoStringer interface, rendering the pair as a call to Pair, which reads better in
+// go-cmp diffs than the struct with its internal fields. The value receiver also covers slices of pairs, such as
+// those returned by Pairs.
+func (k KeyValuePair[K, V]) GoString() string {
+	return fmt.Sprintf("orderedmap.Pair[%T,%T](%#v, %#v)", *new(K), *new(V), k.Key, k.Value)
+}
@@ -1,6 +1,7 @@
 package orderedmap
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -41,3 +42,56 @@ func TestKeyValuePair_String(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyValuePair_GoString(t *testing.T) {
+	type testCase struct {
+		name string
+		k    fmt.GoStringer
+		want string
+	}
+	tests := []testCase{
+		{
+			name: "string value",
+			k:    KeyValuePair[string, string]{Key: "MyValue", Value: "text"},
+			want: `orderedmap.Pair[string,string]("MyValue", "text")`,
+		},
+		{
+			name: "pointer to struct value",
+			k:    &KeyValuePair[string, kvpValue]{Key: "MyValue", Value: kvpValue{Name: "Value", X: 2, Y: 6}},
+			want: `orderedmap.Pair[string,orderedmap.kvpValue]("MyValue", orderedmap.kvpValue{Name:"Value", X:2, Y:6})`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.k.GoString(); got != tt.want {
+				t.Errorf("GoString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("omits the internal element", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1))
+		want := `orderedmap.Pair[string,int]("a", 1)`
+		if got := fmt.Sprintf("%#v", o.items["a"]); got != want {
+			t.Errorf("Sprintf(%%#v) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("applies to slices of pairs", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1), kvp("b", 2))
+		want := `[]orderedmap.KeyValuePair[string,int]{orderedmap.Pair[string,int]("a", 1), orderedmap.Pair[string,int]("b", 2)}`
+		if got := fmt.Sprintf("%#v", o.Pairs()); got != want {
+			t.Errorf("Sprintf(%%#v) = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestPair(t *testing.T) {
+	got := Pair("MyValue", 42)
+	want := KeyValuePair[string, int]{Key: "MyValue", Value: 42}
+	if got != want {
+		t.Errorf("Pair() = %v, want %v", &got, &want)
+	}
+
+	compareOrderedMaps(t, newFromPairs(kvp("a", 1), kvp("b", 2)), Of(Pair("a", 1), Pair("b", 2)))
+}
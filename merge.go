@@ -0,0 +1,126 @@
+package orderedmap
+
+// MergeOrdered returns a new map containing the keys of both a and b, ordered consistently with both inputs where
+// possible. Keys unique to b are placed before the next key which b shares with a.
+//
+// Where the orders of a and b conflict, the order of a takes precedence and the remaining keys unique to b are
+// appended as they are encountered. Values of keys present in both maps are combined via combine(existing, incoming),
+// where existing is the value from a and incoming is the value from b. If combine is nil, the value from b is kept.
+//
+// Neither a nor b is modified. A nil map is treated as empty.
+func MergeOrdered[K comparable, V any](a, b *OrderedMap[K, V], combine func(existing, incoming V) V) *OrderedMap[K, V] {
+	if a == nil {
+		a = New[K, V]()
+	}
+	if b == nil {
+		b = New[K, V]()
+	}
+	if combine == nil {
+		combine = func(_, incoming V) V { return incoming }
+	}
+
+	result := New[K, V]()
+	emitFromA := func(pair *KeyValuePair[K, V]) {
+		if incoming, shared := b.items[pair.Key]; shared {
+			result.Set(pair.Key, combine(pair.Value, incoming.Value))
+			return
+		}
+		result.Set(pair.Key, pair.Value)
+	}
+
+	ae := a.order.Front()
+	for be := b.order.Front(); be != nil; be = be.Next() {
+		key := be.Value.Key
+		if _, shared := a.items[key]; !shared {
+			result.Set(key, be.Value.Value)
+			continue
+		}
+		if _, emitted := result.items[key]; emitted {
+			// orders conflict; a's order has already placed this key
+			continue
+		}
+		// emit a's keys up to and including the shared anchor
+		for ; ae != nil; ae = ae.Next() {
+			emitFromA(ae.Value)
+			if ae.Value.Key == key {
+				ae = ae.Next()
+				break
+			}
+		}
+	}
+
+	for ; ae != nil; ae = ae.Next() {
+		emitFromA(ae.Value)
+	}
+
+	return result
+}
+
+// Merge copies every pair of other into o. Values of existing keys are overwritten while retaining their position,
+// and new keys are appended in the order of other. Merging a nil or empty map is a no-op.
+//
+// This is the ordered analogue of maps.Copy. The receiver is returned to allow chaining.
+func (o *OrderedMap[K, V]) Merge(other *OrderedMap[K, V]) *OrderedMap[K, V] {
+	defer o.holdEvents()()
+	if other == nil {
+		return o
+	}
+	for e := other.order.Front(); e != nil; e = e.Next() {
+		o.Set(e.Value.Key, e.Value.Value)
+	}
+	return o
+}
+
+// Concat returns a new map containing the pairs of each of maps in turn. Values of keys present in more than one map
+// are taken from the last such map, while the key retains the position of its first appearance.
+//
+// This is the variadic analogue of Merge. None of maps is modified, and nil maps are treated as empty.
+func Concat[K comparable, V any](maps ...*OrderedMap[K, V]) *OrderedMap[K, V] {
+	result := New[K, V]()
+	for _, m := range maps {
+		result.Merge(m)
+	}
+	return result
+}
+
+// Union returns a new map containing the pairs of x in order, followed by the pairs of y whose keys are absent from x.
+// Values of keys present in both maps are taken from x.
+//
+// Neither x nor y is modified. A nil map is treated as empty.
+func Union[K comparable, V any](x, y *OrderedMap[K, V]) *OrderedMap[K, V] {
+	result := New[K, V]()
+	for _, m := range []*OrderedMap[K, V]{x, y} {
+		if m == nil {
+			continue
+		}
+		for e := m.order.Front(); e != nil; e = e.Next() {
+			result.SetIfAbsent(e.Value.Key, e.Value.Value)
+		}
+	}
+	return result
+}
+
+// Intersection returns a new map containing the pairs of x whose keys are also present in y, in the order of x.
+// Values are taken from x.
+//
+// Neither x nor y is modified. A nil map is treated as empty.
+func Intersection[K comparable, V any](x, y *OrderedMap[K, V]) *OrderedMap[K, V] {
+	if x == nil || y == nil {
+		return New[K, V]()
+	}
+	return x.Filter(func(key K, _ V) bool {
+		return y.Contains(key)
+	})
+}
+
+// Difference returns a new map containing the pairs of x whose keys are absent from y, in the order of x.
+//
+// Neither x nor y is modified. A nil map is treated as empty.
+func Difference[K comparable, V any](x, y *OrderedMap[K, V]) *OrderedMap[K, V] {
+	if x == nil {
+		return New[K, V]()
+	}
+	return x.Filter(func(key K, _ V) bool {
+		return y == nil || !y.Contains(key)
+	})
+}
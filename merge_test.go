@@ -0,0 +1,197 @@
+package orderedmap
+
+import "testing"
+
+func TestMergeOrdered(t *testing.T) {
+	sum := func(existing, incoming int) int { return existing + incoming }
+	type testCase struct {
+		name    string
+		a       *OrderedMap[string, int]
+		b       *OrderedMap[string, int]
+		combine func(existing, incoming int) int
+		expect  *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:    "nil maps yield empty map",
+			a:       nil,
+			b:       nil,
+			combine: sum,
+			expect:  New[string, int](),
+		},
+		{
+			name:    "compatible orders are interleaved",
+			a:       newFromPairs(kvp("a", 1), kvp("c", 3), kvp("e", 5)),
+			b:       newFromPairs(kvp("b", 2), kvp("c", 30), kvp("d", 4), kvp("e", 50), kvp("f", 6)),
+			combine: sum,
+			expect:  newFromPairs(kvp("b", 2), kvp("a", 1), kvp("c", 33), kvp("d", 4), kvp("e", 55), kvp("f", 6)),
+		},
+		{
+			name:    "disjoint maps place b's keys first",
+			a:       newFromPairs(kvp("a", 1), kvp("b", 2)),
+			b:       newFromPairs(kvp("x", 1), kvp("y", 2)),
+			combine: sum,
+			expect:  newFromPairs(kvp("x", 1), kvp("y", 2), kvp("a", 1), kvp("b", 2)),
+		},
+		{
+			name:    "conflicting orders keep a's order and append b's unique keys",
+			a:       newFromPairs(kvp("p", 1), kvp("q", 2), kvp("r", 3)),
+			b:       newFromPairs(kvp("r", 30), kvp("u", 40), kvp("p", 10)),
+			combine: sum,
+			expect:  newFromPairs(kvp("p", 11), kvp("q", 2), kvp("r", 33), kvp("u", 40)),
+		},
+		{
+			name:    "nil combine keeps b's value",
+			a:       newFromPairs(kvp("a", 1), kvp("b", 2)),
+			b:       newFromPairs(kvp("b", 20)),
+			combine: nil,
+			expect:  newFromPairs(kvp("a", 1), kvp("b", 20)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compareOrderedMaps(t, tt.expect, MergeOrdered(tt.a, tt.b, tt.combine))
+		})
+	}
+}
+
+func TestOrderedMap_Merge(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		other  *OrderedMap[string, int]
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "merging nil is a no-op",
+			o:      newFromPairs(kvp("a", 1)),
+			other:  nil,
+			expect: newFromPairs(kvp("a", 1)),
+		},
+		{
+			name:   "merging an empty map is a no-op",
+			o:      newFromPairs(kvp("a", 1)),
+			other:  New[string, int](),
+			expect: newFromPairs(kvp("a", 1)),
+		},
+		{
+			name:   "overwrites existing keys in place and appends new keys in order",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			other:  newFromPairs(kvp("z", 26), kvp("b", 20), kvp("y", 25)),
+			expect: newFromPairs(kvp("a", 1), kvp("b", 20), kvp("c", 3), kvp("z", 26), kvp("y", 25)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Merge(tt.other); got != tt.o {
+				t.Errorf("Merge() should return the receiver")
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
+func TestConcat(t *testing.T) {
+	type testCase struct {
+		name string
+		maps []*OrderedMap[string, int]
+		want *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name: "no maps",
+			want: New[string, int](),
+		},
+		{
+			name: "nil maps are empty",
+			maps: []*OrderedMap[string, int]{nil, newFromPairs(kvp("a", 1)), nil},
+			want: newFromPairs(kvp("a", 1)),
+		},
+		{
+			name: "joins maps in order",
+			maps: []*OrderedMap[string, int]{newFromPairs(kvp("a", 1)), newFromPairs(kvp("b", 2)), newFromPairs(kvp("c", 3))},
+			want: newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name: "later values win at the first position",
+			maps: []*OrderedMap[string, int]{
+				newFromPairs(kvp("a", 1), kvp("b", 2)),
+				newFromPairs(kvp("c", 3), kvp("a", 10)),
+				newFromPairs(kvp("b", 20)),
+			},
+			want: newFromPairs(kvp("a", 10), kvp("b", 20), kvp("c", 3)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compareOrderedMaps(t, tt.want, Concat(tt.maps...))
+		})
+	}
+
+	t.Run("does not modify inputs", func(t *testing.T) {
+		first := newFromPairs(kvp("a", 1))
+		_ = Concat(first, newFromPairs(kvp("a", 2), kvp("b", 3)))
+		compareOrderedMaps(t, newFromPairs(kvp("a", 1)), first)
+	})
+}
+
+func TestSetOperations(t *testing.T) {
+	type testCase struct {
+		name             string
+		x                *OrderedMap[string, int]
+		y                *OrderedMap[string, int]
+		wantUnion        *OrderedMap[string, int]
+		wantIntersection *OrderedMap[string, int]
+		wantDifference   *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:             "nil maps are empty",
+			x:                nil,
+			y:                nil,
+			wantUnion:        New[string, int](),
+			wantIntersection: New[string, int](),
+			wantDifference:   New[string, int](),
+		},
+		{
+			name:             "nil y",
+			x:                newFromPairs(kvp("a", 1), kvp("b", 2)),
+			y:                nil,
+			wantUnion:        newFromPairs(kvp("a", 1), kvp("b", 2)),
+			wantIntersection: New[string, int](),
+			wantDifference:   newFromPairs(kvp("a", 1), kvp("b", 2)),
+		},
+		{
+			name:             "nil x",
+			x:                nil,
+			y:                newFromPairs(kvp("a", 1), kvp("b", 2)),
+			wantUnion:        newFromPairs(kvp("a", 1), kvp("b", 2)),
+			wantIntersection: New[string, int](),
+			wantDifference:   New[string, int](),
+		},
+		{
+			name:             "overlapping keys keep order and values of x",
+			x:                newFromPairs(kvp("c", 3), kvp("a", 1), kvp("b", 2)),
+			y:                newFromPairs(kvp("d", 40), kvp("b", 20), kvp("c", 30)),
+			wantUnion:        newFromPairs(kvp("c", 3), kvp("a", 1), kvp("b", 2), kvp("d", 40)),
+			wantIntersection: newFromPairs(kvp("c", 3), kvp("b", 2)),
+			wantDifference:   newFromPairs(kvp("a", 1)),
+		},
+		{
+			name:             "disjoint keys",
+			x:                newFromPairs(kvp("a", 1)),
+			y:                newFromPairs(kvp("b", 2)),
+			wantUnion:        newFromPairs(kvp("a", 1), kvp("b", 2)),
+			wantIntersection: New[string, int](),
+			wantDifference:   newFromPairs(kvp("a", 1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compareOrderedMaps(t, tt.wantUnion, Union(tt.x, tt.y))
+			compareOrderedMaps(t, tt.wantIntersection, Intersection(tt.x, tt.y))
+			compareOrderedMaps(t, tt.wantDifference, Difference(tt.x, tt.y))
+		})
+	}
+}
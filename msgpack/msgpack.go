@@ -0,0 +1,116 @@
+// Package msgpack provides order-preserving msgpack encoding for orderedmap.OrderedMap.
+//
+// This lives in a separate package to keep msgpack-specific API out of the core package, although
+// github.com/vmihailenco/msgpack/v5 is still a requirement of this module. The map is encoded as a msgpack map whose
+// entries are written in the map's order, as are any nested ordered maps.
+package msgpack
+
+import (
+	orderedmap "github.com/jimschubert/ordered-map"
+	"github.com/jimschubert/ordered-map/internal/nested"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Map wraps an OrderedMap to fulfill msgpack.Marshaler and msgpack.Unmarshaler.
+type Map[K comparable, V any] struct {
+	*orderedmap.OrderedMap[K, V]
+}
+
+// Wrap an OrderedMap for msgpack encoding. If o is nil, a new OrderedMap is allocated.
+func Wrap[K comparable, V any](o *orderedmap.OrderedMap[K, V]) *Map[K, V] {
+	if o == nil {
+		o = orderedmap.New[K, V]()
+	}
+	return &Map[K, V]{OrderedMap: o}
+}
+
+// MarshalMsgpack encodes the wrapped map as a msgpack map, writing entries in order.
+func (m *Map[K, V]) MarshalMsgpack() ([]byte, error) {
+	return Marshal(m.OrderedMap)
+}
+
+// UnmarshalMsgpack decodes a msgpack map into the wrapped map, preserving the encoded order.
+// Any existing contents of the wrapped map are cleared.
+func (m *Map[K, V]) UnmarshalMsgpack(data []byte) error {
+	if m.OrderedMap == nil {
+		m.OrderedMap = orderedmap.New[K, V]()
+	}
+	return Unmarshal(data, m.OrderedMap)
+}
+
+// Marshal encodes o as a msgpack map, writing entries in order. A nil map is encoded as msgpack nil.
+// Values which are themselves an *orderedmap.OrderedMap, of any type parameters, are encoded in order as well.
+func Marshal[K comparable, V any](o *orderedmap.OrderedMap[K, V]) ([]byte, error) {
+	if o == nil {
+		return msgpack.Marshal(nil)
+	}
+
+	e := &encodable{keys: make([]any, 0, o.Len()), values: make([]any, 0, o.Len())}
+	for k, v := range o.All() {
+		e.keys = append(e.keys, k)
+		e.values = append(e.values, v)
+	}
+	return msgpack.Marshal(e)
+}
+
+// Unmarshal decodes a msgpack map from data into o, preserving the encoded order.
+// Any existing contents of o are cleared. The map o must not be nil.
+func Unmarshal[K comparable, V any](data []byte, o *orderedmap.OrderedMap[K, V]) error {
+	decoded := &decodable[K, V]{target: o.Init()}
+	return msgpack.Unmarshal(data, decoded)
+}
+
+type encodable struct {
+	keys   []any
+	values []any
+}
+
+func (e *encodable) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if err := enc.EncodeMapLen(len(e.keys)); err != nil {
+		return err
+	}
+	for i := range e.keys {
+		if err := enc.Encode(e.keys[i]); err != nil {
+			return err
+		}
+		if err := encodeValue(enc, e.values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeValue encodes v, recursing into nested ordered maps which msgpack would otherwise encode as empty maps.
+func encodeValue(enc *msgpack.Encoder, v any) error {
+	keys, values, isNil, ok := nested.Pairs(v)
+	switch {
+	case !ok:
+		return enc.Encode(v)
+	case isNil:
+		return enc.EncodeNil()
+	}
+	return (&encodable{keys: keys, values: values}).EncodeMsgpack(enc)
+}
+
+type decodable[K comparable, V any] struct {
+	target *orderedmap.OrderedMap[K, V]
+}
+
+func (d *decodable[K, V]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	n, err := dec.DecodeMapLen()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		var key K
+		if err := dec.Decode(&key); err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		d.target.Set(key, value)
+	}
+	return nil
+}
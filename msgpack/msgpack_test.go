@@ -0,0 +1,134 @@
+package msgpack
+
+import (
+	"testing"
+
+	orderedmap "github.com/jimschubert/ordered-map"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestMarshalUnmarshal_roundTrip(t *testing.T) {
+	t.Run("string keys", func(t *testing.T) {
+		o := orderedmap.New[string, string]().
+			Set("zebra", "z").
+			Set("apple", "a").
+			Set("mango", "m")
+
+		data, err := Marshal(o)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+
+		got := orderedmap.New[string, string]().Set("stale", "value")
+		if err := Unmarshal(data, got); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if !orderedmap.Equal(o, got) {
+			t.Errorf("round trip = %#v, want %#v", got, o)
+		}
+	})
+
+	t.Run("integer keys", func(t *testing.T) {
+		o := orderedmap.New[int, []string]().
+			Set(300, []string{"c"}).
+			Set(-1, []string{"a", "b"}).
+			Set(70000, nil)
+
+		data, err := Marshal(o)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+
+		got := orderedmap.New[int, []string]()
+		if err := Unmarshal(data, got); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if !orderedmap.Equal(o, got) {
+			t.Errorf("round trip = %#v, want %#v", got, o)
+		}
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		o := orderedmap.New[int, int]()
+		data, err := Marshal(o)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+
+		got := orderedmap.New[int, int]()
+		if err := Unmarshal(data, got); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if !orderedmap.Equal(o, got) {
+			t.Errorf("round trip = %#v, want %#v", got, o)
+		}
+	})
+}
+
+func TestMap_nested(t *testing.T) {
+	type document struct {
+		Name   string
+		Fields *Map[string, int]
+	}
+
+	in := document{
+		Name:   "doc",
+		Fields: Wrap(orderedmap.New[string, int]().Set("b", 2).Set("a", 1).Set("c", 3)),
+	}
+
+	data, err := msgpack.Marshal(in)
+	if err != nil {
+		t.Fatalf("msgpack.Marshal() error = %v", err)
+	}
+
+	var out document
+	if err := msgpack.Unmarshal(data, &out); err != nil {
+		t.Fatalf("msgpack.Unmarshal() error = %v", err)
+	}
+
+	if out.Name != in.Name {
+		t.Errorf("Name = %q, want %q", out.Name, in.Name)
+	}
+	if !orderedmap.Equal(in.Fields.OrderedMap, out.Fields.OrderedMap) {
+		t.Errorf("Fields = %#v, want %#v", out.Fields.OrderedMap, in.Fields.OrderedMap)
+	}
+}
+
+func TestMarshal_encodesMapInOrder(t *testing.T) {
+	o := orderedmap.New[string, int]().Set("b", 2).Set("a", 1)
+	data, err := Marshal(o)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	// fixmap(2), fixstr "b", 2, fixstr "a", 1
+	want := []byte{0x82, 0xa1, 'b', 0x02, 0xa1, 'a', 0x01}
+	if string(data) != string(want) {
+		t.Errorf("Marshal() = %x, want %x", data, want)
+	}
+}
+
+func TestMarshal_nestedRawMaps(t *testing.T) {
+	inner := orderedmap.New[string, int]().Set("z", 26).Set("a", 1)
+	o := orderedmap.New[string, any]().
+		Set("inner", inner).
+		Set("nil", (*orderedmap.OrderedMap[string, int])(nil))
+	data, err := Marshal(o)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	// fixmap(2), fixstr "inner", fixmap(2) {fixstr "z", 26, fixstr "a", 1}, fixstr "nil", nil
+	want := []byte{0x82, 0xa5, 'i', 'n', 'n', 'e', 'r', 0x82, 0xa1, 'z', 0x1a, 0xa1, 'a', 0x01, 0xa3, 'n', 'i', 'l', 0xc0}
+	if string(data) != string(want) {
+		t.Errorf("Marshal() = %x, want %x", data, want)
+	}
+
+	got := orderedmap.New[string, *Map[string, int]]()
+	if err := Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if decoded, _ := got.Get("inner"); !orderedmap.Equal(inner, (*decoded).OrderedMap) {
+		t.Errorf("Unmarshal() inner = %#v, want %#v", (*decoded).OrderedMap, inner)
+	}
+}
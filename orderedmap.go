@@ -3,6 +3,11 @@ package orderedmap
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/jimschubert/ordered-map/internal/list"
 )
@@ -13,30 +18,82 @@ import (
 // Operations to manipulate the order are exposed which mirror the API of stdlib's List.
 //
 // NOTE: This map maintains ordering, _not_ sorting.
+//
+// Compare maps with Equal rather than reflect.DeepEqual or reflection-based libraries such as testify or go-cmp. The
+// internal order tracks a count of structural modifications so iterators can detect concurrent changes, so two maps
+// holding the same pairs in the same order are not reflect.DeepEqual unless they were built by the same sequence of
+// operations.
 type OrderedMap[K comparable, V any] struct {
 	items map[K]*KeyValuePair[K, V]
 	order list.List[*KeyValuePair[K, V]]
+
+	// timestamps is nil unless enabled via EnableTimestamps
+	timestamps        map[K]time.Time
+	refreshTimestamps bool
+
+	// subscribers is nil until the first call to Subscribe
+	subscribers *subscribers[K, V]
 }
 
 // Init initializes or clears ordered map o.
 func (o *OrderedMap[K, V]) Init() *OrderedMap[K, V] {
 	o.items = make(map[K]*KeyValuePair[K, V])
 	o.order.Init()
+	if o.timestamps != nil {
+		o.timestamps = make(map[K]time.Time)
+	}
+	o.notify(ChangeClear, *new(K), *new(V), *new(V))
 	return o
 }
 
+// Clear removes all pairs from the map. This is equivalent to Init, but reads more naturally as a statement.
+func (o *OrderedMap[K, V]) Clear() {
+	o.Init()
+}
+
+// Compact rebuilds the map's internal index sized to the current length, reclaiming memory held after many removals.
+// The built-in map never shrinks, so this benefits long-lived maps with heavy churn. The complexity is O(n).
+//
+// Order is preserved, and the existing pairs are retained, so pointers obtained via GetRef remain valid.
+func (o *OrderedMap[K, V]) Compact() {
+	items := make(map[K]*KeyValuePair[K, V], len(o.items))
+	for key, kvp := range o.items {
+		items[key] = kvp
+	}
+	o.items = items
+
+	if o.timestamps != nil {
+		timestamps := make(map[K]time.Time, len(o.timestamps))
+		for key, ts := range o.timestamps {
+			timestamps[key] = ts
+		}
+		o.timestamps = timestamps
+	}
+}
+
 func (o *OrderedMap[K, V]) insertKeyValuePair(key K, value V) *KeyValuePair[K, V] {
+	pair := o.pushKeyValuePair(key, value)
+	o.notify(ChangeInsert, key, *new(V), value)
+	return pair
+}
+
+// pushKeyValuePair appends a new pair without notifying, for callers which reposition the pair before notifying.
+func (o *OrderedMap[K, V]) pushKeyValuePair(key K, value V) *KeyValuePair[K, V] {
 	pair := KeyValuePair[K, V]{Key: key, Value: value}
 	element := o.order.PushBack(&pair)
 	o.items[key] = &pair
 	pair.element = element
+	o.touch(key, false)
 	return &pair
 }
 
 // Set a key of type K to a value of type V. If the key exists, the value will be modified.
 func (o *OrderedMap[K, V]) Set(key K, value V) *OrderedMap[K, V] {
 	if existing, ok := o.items[key]; ok {
+		oldValue := existing.Value
 		existing.Value = value
+		o.touch(key, true)
+		o.notify(ChangeUpdate, key, oldValue, value)
 		return o
 	}
 
@@ -44,6 +101,23 @@ func (o *OrderedMap[K, V]) Set(key K, value V) *OrderedMap[K, V] {
 	return o
 }
 
+// GetAndSet sets key to value, following the semantics of Set, and returns the value previously stored at key.
+// Returns nil and false if the key did not previously exist.
+func (o *OrderedMap[K, V]) GetAndSet(key K, value V) (*V, bool) {
+	previous, ok := o.Get(key)
+	o.Set(key, value)
+	return previous, ok
+}
+
+// SetMany sets each of the pairs in sequence, following the semantics of Set.
+func (o *OrderedMap[K, V]) SetMany(pairs ...KeyValuePair[K, V]) *OrderedMap[K, V] {
+	defer o.holdEvents()()
+	for _, pair := range pairs {
+		o.Set(pair.Key, pair.Value)
+	}
+	return o
+}
+
 // Get the value stored at the key.
 func (o *OrderedMap[K, V]) Get(key K) (*V, bool) {
 	if existing, ok := o.items[key]; ok {
@@ -54,6 +128,46 @@ func (o *OrderedMap[K, V]) Get(key K) (*V, bool) {
 	return nil, false
 }
 
+// GetRef returns a pointer to the value stored at the key, allowing the stored value to be modified in place.
+//
+// Unlike Get, writes through the returned pointer are reflected in the map. Such writes are not observed by
+// subscribers or insertion timestamps. The pointer is invalidated if the key is removed from the map; writes after
+// removal do not modify the map.
+func (o *OrderedMap[K, V]) GetRef(key K) (*V, bool) {
+	if existing, ok := o.items[key]; ok {
+		return &existing.Value, true
+	}
+
+	return nil, false
+}
+
+// Contains reports whether key exists in the map.
+func (o *OrderedMap[K, V]) Contains(key K) bool {
+	_, ok := o.items[key]
+	return ok
+}
+
+// ContainsValue reports whether any key in the map holds a value equal to v, as determined by eq.
+//
+// This walks the map from the front, so the complexity is O(n).
+func (o *OrderedMap[K, V]) ContainsValue(v V, eq func(a, b V) bool) bool {
+	_, ok := o.FindByValue(v, eq)
+	return ok
+}
+
+// FindByValue returns the first key, in order, which holds a value equal to v as determined by eq.
+// Returns the zero value of K and false if no such key exists.
+//
+// This walks the map from the front, so the complexity is O(n).
+func (o *OrderedMap[K, V]) FindByValue(v V, eq func(a, b V) bool) (K, bool) {
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		if eq(e.Value.Value, v) {
+			return e.Value.Key, true
+		}
+	}
+	return *new(K), false
+}
+
 // GetOrDefault either gets teh value stored at key or returns the default value defined by defaultValue
 func (o *OrderedMap[K, V]) GetOrDefault(key K, defaultValue V) V {
 	value, ok := o.Get(key)
@@ -64,19 +178,186 @@ func (o *OrderedMap[K, V]) GetOrDefault(key K, defaultValue V) V {
 	return *value
 }
 
+// SetIfAbsent sets key to value only if key does not already exist, appending it to the back of the map.
+// Returns the value now stored at key, and true if it was newly inserted.
+func (o *OrderedMap[K, V]) SetIfAbsent(key K, value V) (V, bool) {
+	if existing, ok := o.items[key]; ok {
+		return existing.Value, false
+	}
+
+	_ = o.insertKeyValuePair(key, value)
+	return value, true
+}
+
+// ComputeIfAbsent returns the value stored at key. If key does not exist, the value is constructed by calling f and
+// appended to the back of the map; f is not called otherwise.
+func (o *OrderedMap[K, V]) ComputeIfAbsent(key K, f func() V) V {
+	if existing, ok := o.items[key]; ok {
+		return existing.Value
+	}
+
+	value := f()
+	_ = o.insertKeyValuePair(key, value)
+	return value
+}
+
 // Remove the key (and value) from the map.
 // Returns the removed value and true if the value has been removed.
 // Returns nil and false if the item did not exist in the map.
 func (o *OrderedMap[K, V]) Remove(key K) (*KeyValuePair[K, V], bool) {
 	if kvp, ok := o.items[key]; ok {
-		delete(o.items, key)
-		o.order.Remove(kvp.element)
+		o.removeKeyValuePair(kvp)
 		return kvp, true
 	}
 
 	return nil, false
 }
 
+func (o *OrderedMap[K, V]) removeKeyValuePair(kvp *KeyValuePair[K, V]) {
+	delete(o.items, kvp.Key)
+	delete(o.timestamps, kvp.Key)
+	o.order.Remove(kvp.element)
+	o.notify(ChangeRemove, kvp.Key, kvp.Value, *new(V))
+}
+
+// RemoveIf removes all pairs for which pred returns true in a single pass, returning the number of pairs removed.
+func (o *OrderedMap[K, V]) RemoveIf(pred func(K, V) bool) int {
+	defer o.holdEvents()()
+	removed := 0
+	for e := o.order.Front(); e != nil; {
+		// capture next before unlinking e, which clears its pointers
+		next := e.Next()
+		if pred(e.Value.Key, e.Value.Value) {
+			o.removeKeyValuePair(e.Value)
+			removed++
+		}
+		e = next
+	}
+	return removed
+}
+
+// RetainKeys removes all pairs whose key is not one of keys, retaining the relative order of the remaining pairs.
+// Returns the number of pairs removed.
+func (o *OrderedMap[K, V]) RetainKeys(keys ...K) int {
+	retain := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		retain[key] = struct{}{}
+	}
+	return o.RemoveIf(func(key K, _ V) bool {
+		_, ok := retain[key]
+		return !ok
+	})
+}
+
+// RemoveKeys removes all pairs whose key is one of keys, returning the number of pairs removed.
+// Keys which do not exist in the map are ignored.
+func (o *OrderedMap[K, V]) RemoveKeys(keys ...K) int {
+	defer o.holdEvents()()
+	removed := 0
+	for _, key := range keys {
+		if _, ok := o.Remove(key); ok {
+			removed++
+		}
+	}
+	return removed
+}
+
+// Filter returns a new map containing only the pairs for which pred returns true, retaining their relative order.
+// The original map is not modified.
+func (o *OrderedMap[K, V]) Filter(pred func(K, V) bool) *OrderedMap[K, V] {
+	filtered := New[K, V]()
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		if pred(e.Value.Key, e.Value.Value) {
+			_ = filtered.insertKeyValuePair(e.Value.Key, e.Value.Value)
+		}
+	}
+	return filtered
+}
+
+// Partition splits the map in a single pass into two new maps: matched, containing the pairs for which pred returns
+// true, and rest, containing all other pairs. Each retains the relative order of its pairs. The original map is not
+// modified.
+func (o *OrderedMap[K, V]) Partition(pred func(K, V) bool) (matched, rest *OrderedMap[K, V]) {
+	matched, rest = New[K, V](), New[K, V]()
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		if pred(e.Value.Key, e.Value.Value) {
+			_ = matched.insertKeyValuePair(e.Value.Key, e.Value.Value)
+		} else {
+			_ = rest.insertKeyValuePair(e.Value.Key, e.Value.Value)
+		}
+	}
+	return matched, rest
+}
+
+// Slice returns a new map containing the pairs at positions within the half-open range [start, end), retaining
+// their relative order. Bounds are clamped to the map, so an empty map is returned if the range does not overlap.
+// The original map is not modified.
+func (o *OrderedMap[K, V]) Slice(start, end int) *OrderedMap[K, V] {
+	start = max(start, 0)
+	end = min(end, o.order.Len())
+
+	sliced := New[K, V]()
+	if start >= end {
+		return sliced
+	}
+
+	e := o.order.Front()
+	for i := 0; i < start; i++ {
+		e = e.Next()
+	}
+	for i := start; i < end; i++ {
+		_ = sliced.insertKeyValuePair(e.Value.Key, e.Value.Value)
+		e = e.Next()
+	}
+	return sliced
+}
+
+// ReplaceAll clears o and copies all pairs of src into o, in order.
+//
+// The backing storage of o is retained and reused, which avoids reallocation when refreshing a long-lived map in place.
+// If src is nil, o is cleared. If src is o, the map is unmodified.
+func (o *OrderedMap[K, V]) ReplaceAll(src *OrderedMap[K, V]) {
+	defer o.holdEvents()()
+	if src == o {
+		return
+	}
+
+	clear(o.items)
+	clear(o.timestamps)
+	o.order.Init()
+	if o.items == nil {
+		o.items = make(map[K]*KeyValuePair[K, V])
+	}
+	o.notify(ChangeClear, *new(K), *new(V), *new(V))
+	if src == nil {
+		return
+	}
+
+	for e := src.order.Front(); e != nil; e = e.Next() {
+		_ = o.insertKeyValuePair(e.Value.Key, e.Value.Value)
+	}
+}
+
+// Clone returns an independent copy of the map with the same keys, values, and order.
+//
+// Values are copied by assignment, so this is a shallow copy. Recorded insertion timestamps are copied, but
+// subscribers are not. A nil map returns nil.
+func (o *OrderedMap[K, V]) Clone() *OrderedMap[K, V] {
+	if o == nil {
+		return nil
+	}
+
+	clone := New[K, V]()
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		_ = clone.insertKeyValuePair(e.Value.Key, e.Value.Value)
+	}
+	if o.timestamps != nil {
+		clone.timestamps = maps.Clone(o.timestamps)
+		clone.refreshTimestamps = o.refreshTimestamps
+	}
+	return clone
+}
+
 // First returns the first KeyValuePair contained in the map, or nil.
 func (o *OrderedMap[K, V]) First() *KeyValuePair[K, V] {
 	front := o.order.Front()
@@ -95,14 +376,113 @@ func (o *OrderedMap[K, V]) Last() *KeyValuePair[K, V] {
 	return last.Value
 }
 
+// PopFirst removes and returns the first KeyValuePair contained in the map.
+// Returns nil and false if the map is empty.
+func (o *OrderedMap[K, V]) PopFirst() (*KeyValuePair[K, V], bool) {
+	front := o.order.Front()
+	if front == nil {
+		return nil, false
+	}
+	o.removeKeyValuePair(front.Value)
+	return front.Value, true
+}
+
+// PopLast removes and returns the last KeyValuePair contained in the map.
+// Returns nil and false if the map is empty.
+func (o *OrderedMap[K, V]) PopLast() (*KeyValuePair[K, V], bool) {
+	back := o.order.Back()
+	if back == nil {
+		return nil, false
+	}
+	o.removeKeyValuePair(back.Value)
+	return back.Value, true
+}
+
+// Truncate retains only the first n pairs of the map, removing the rest.
+// If n is at least Len, the map is unmodified. If n is not positive, the map is cleared.
+func (o *OrderedMap[K, V]) Truncate(n int) {
+	defer o.holdEvents()()
+	if n <= 0 {
+		o.Clear()
+		return
+	}
+
+	for o.order.Len() > n {
+		o.removeKeyValuePair(o.order.Back().Value)
+	}
+}
+
+// At returns the KeyValuePair at the zero-based position index, or nil and false if index is out of range.
+// Negative indices count from the back of the map, such that At(-1) is equivalent to Last.
+//
+// This walks the map from the nearest end, so the complexity is O(n).
+func (o *OrderedMap[K, V]) At(index int) (*KeyValuePair[K, V], bool) {
+	n := o.order.Len()
+	if index < 0 {
+		index += n
+	}
+	if index < 0 || index >= n {
+		return nil, false
+	}
+
+	if index < n/2 {
+		e := o.order.Front()
+		for i := 0; i < index; i++ {
+			e = e.Next()
+		}
+		return e.Value, true
+	}
+
+	e := o.order.Back()
+	for i := n - 1; i > index; i-- {
+		e = e.Prev()
+	}
+	return e.Value, true
+}
+
+// IndexOf returns the zero-based position of key in the map's order, or -1 if key does not exist.
+//
+// This walks the map from the front, so the complexity is O(n).
+func (o *OrderedMap[K, V]) IndexOf(key K) int {
+	kvp, ok := o.items[key]
+	if !ok {
+		return -1
+	}
+
+	index := 0
+	for e := o.order.Front(); e != kvp.element; e = e.Next() {
+		index++
+	}
+	return index
+}
+
 // Iterator returns an initialized *Iterator[K, V] for walking the map's contents in-order.
 func (o *OrderedMap[K, V]) Iterator() *Iterator[K, V] {
 	return &Iterator[K, V]{
 		pos:        o.order.Front(),
 		orderedMap: o,
+		mods:       o.order.Mods(),
+	}
+}
+
+// ReverseIterator returns an initialized *Iterator[K, V] for walking the map's contents in reverse order.
+func (o *OrderedMap[K, V]) ReverseIterator() *Iterator[K, V] {
+	return &Iterator[K, V]{
+		pos:        o.order.Back(),
+		orderedMap: o,
+		reverse:    true,
+		mods:       o.order.Mods(),
 	}
 }
 
+// Len returns the number of pairs in the map. The complexity is O(1).
+func (o *OrderedMap[K, V]) Len() int {
+	if o == nil {
+		return 0
+	}
+	return o.order.Len()
+}
+
 // Keys returns the ordered slice of keys for this map
 func (o *OrderedMap[K, V]) Keys() []K {
 	keys := make([]K, 0)
@@ -118,6 +498,86 @@ func (o *OrderedMap[K, V]) Keys() []K {
 	return keys
 }
 
+// Values returns the ordered slice of values for this map
+func (o *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, o.order.Len())
+	it := o.Iterator()
+	var kvp *KeyValuePair[K, V]
+	for {
+		kvp = it.Next()
+		if kvp == nil {
+			break
+		}
+		values = append(values, kvp.Value)
+	}
+	return values
+}
+
+// AppendKeys appends the keys of this map to dst in order, returning the extended slice.
+// This allows the caller to reuse a slice's backing array across calls, unlike Keys.
+func (o *OrderedMap[K, V]) AppendKeys(dst []K) []K {
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		dst = append(dst, e.Value.Key)
+	}
+	return dst
+}
+
+// AppendValues appends the values of this map to dst in order, returning the extended slice.
+// This allows the caller to reuse a slice's backing array across calls, unlike Values.
+func (o *OrderedMap[K, V]) AppendValues(dst []V) []V {
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		dst = append(dst, e.Value.Value)
+	}
+	return dst
+}
+
+// Pairs returns the ordered slice of pairs for this map.
+// Each pair is a copy, so modifying the returned pairs does not modify the map.
+func (o *OrderedMap[K, V]) Pairs() []KeyValuePair[K, V] {
+	pairs := make([]KeyValuePair[K, V], 0, o.order.Len())
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		pairs = append(pairs, KeyValuePair[K, V]{Key: e.Value.Key, Value: e.Value.Value})
+	}
+	return pairs
+}
+
+// FirstN returns copies of up to n pairs from the front of the map, in order.
+// If n exceeds Len, all pairs are returned. If n is not positive, an empty slice is returned.
+func (o *OrderedMap[K, V]) FirstN(n int) []KeyValuePair[K, V] {
+	n = min(max(n, 0), o.order.Len())
+	pairs := make([]KeyValuePair[K, V], 0, n)
+	for e := o.order.Front(); len(pairs) < n; e = e.Next() {
+		pairs = append(pairs, KeyValuePair[K, V]{Key: e.Value.Key, Value: e.Value.Value})
+	}
+	return pairs
+}
+
+// LastN returns copies of up to n pairs from the back of the map, in order (the last pair of the map is last).
+// If n exceeds Len, all pairs are returned. If n is not positive, an empty slice is returned.
+func (o *OrderedMap[K, V]) LastN(n int) []KeyValuePair[K, V] {
+	n = min(max(n, 0), o.order.Len())
+	pairs := make([]KeyValuePair[K, V], n)
+	e := o.order.Back()
+	for i := n - 1; i >= 0; i-- {
+		pairs[i] = KeyValuePair[K, V]{Key: e.Value.Key, Value: e.Value.Value}
+		e = e.Prev()
+	}
+	return pairs
+}
+
+// ToMap returns a copy of the map's contents as a built-in map, which does not retain order.
+// A nil map returns an empty map.
+func (o *OrderedMap[K, V]) ToMap() map[K]V {
+	if o == nil {
+		return make(map[K]V)
+	}
+	m := make(map[K]V, len(o.items))
+	for key, kvp := range o.items {
+		m[key] = kvp.Value
+	}
+	return m
+}
+
 // MoveToFront allows for manipulating the order of a map by moving key (and associated value) to the front of the map.
 //
 // If key does not exist in the map, this will raise a KeyNotFoundError to signal failed intent to the caller.
@@ -126,6 +586,7 @@ func (o *OrderedMap[K, V]) Keys() []K {
 func (o *OrderedMap[K, V]) MoveToFront(key K) error {
 	if element, ok := o.items[key]; ok {
 		o.order.MoveToFront(element.element)
+		o.notify(ChangeMove, key, element.Value, element.Value)
 		return nil
 	}
 	return keyNotFound(key)
@@ -139,11 +600,24 @@ func (o *OrderedMap[K, V]) MoveToFront(key K) error {
 func (o *OrderedMap[K, V]) MoveToBack(key K) error {
 	if element, ok := o.items[key]; ok {
 		o.order.MoveToBack(element.element)
+		o.notify(ChangeMove, key, element.Value, element.Value)
 		return nil
 	}
 	return keyNotFound(key)
 }
 
+// MoveToFrontIfExists moves key (and associated value) to the front of the map if key exists, reporting whether it
+// was moved. Unlike MoveToFront, a missing key is not an error, which suits promoting entries in a cache.
+func (o *OrderedMap[K, V]) MoveToFrontIfExists(key K) bool {
+	return o.MoveToFront(key) == nil
+}
+
+// MoveToBackIfExists moves key (and associated value) to the back of the map if key exists, reporting whether it
+// was moved. Unlike MoveToBack, a missing key is not an error.
+func (o *OrderedMap[K, V]) MoveToBackIfExists(key K) bool {
+	return o.MoveToBack(key) == nil
+}
+
 // MoveAfter allows for manipulating the order of a map by moving the pair defined at 'key' after the pair defined at 'after'.
 //
 // If either element is not found, this will raise a KeyNotFoundError to signal failed intent to the caller.
@@ -154,6 +628,7 @@ func (o *OrderedMap[K, V]) MoveAfter(key, after K) error {
 	if element, ok := o.items[key]; ok {
 		if mark, exists := o.items[after]; exists {
 			o.order.MoveAfter(element.element, mark.element)
+			o.notify(ChangeMove, key, element.Value, element.Value)
 			return nil
 		}
 
@@ -173,6 +648,7 @@ func (o *OrderedMap[K, V]) MoveBefore(key, before K) error {
 	if element, ok := o.items[key]; ok {
 		if mark, exists := o.items[before]; exists {
 			o.order.MoveBefore(element.element, mark.element)
+			o.notify(ChangeMove, key, element.Value, element.Value)
 			return nil
 		}
 
@@ -182,9 +658,175 @@ func (o *OrderedMap[K, V]) MoveBefore(key, before K) error {
 	return keyNotFound(key)
 }
 
-// InsertAfter allows for manipulating the order of a map by inserting the provided key and value after the pair defined at 'after'.
+// MoveBeforeAll moves the pairs defined at keys before the pair defined at 'before', as a contiguous block which keeps
+// the relative order of those pairs in the map. Keys repeated in keys are moved once.
+//
+// If any element is not found, this will raise a KeyNotFoundError. If before is among keys, this will raise a
+// DuplicateKeyValueError. In either case, the map is unmodified.
+func (o *OrderedMap[K, V]) MoveBeforeAll(keys []K, before K) error {
+	defer o.holdEvents()()
+	mark, elements, err := o.moveAllElements(keys, before)
+	if err != nil {
+		return err
+	}
+	for _, e := range elements {
+		o.order.MoveBefore(e.element, mark.element)
+	}
+	for _, e := range elements {
+		o.notify(ChangeMove, e.Key, e.Value, e.Value)
+	}
+	return nil
+}
+
+// MoveAfterAll moves the pairs defined at keys after the pair defined at 'after', as a contiguous block which keeps
+// the relative order of those pairs in the map. Keys repeated in keys are moved once.
 //
+// If any element is not found, this will raise a KeyNotFoundError. If after is among keys, this will raise a
+// DuplicateKeyValueError. In either case, the map is unmodified.
+func (o *OrderedMap[K, V]) MoveAfterAll(keys []K, after K) error {
+	defer o.holdEvents()()
+	mark, elements, err := o.moveAllElements(keys, after)
+	if err != nil {
+		return err
+	}
+	for i := len(elements) - 1; i >= 0; i-- {
+		o.order.MoveAfter(elements[i].element, mark.element)
+	}
+	for _, e := range elements {
+		o.notify(ChangeMove, e.Key, e.Value, e.Value)
+	}
+	return nil
+}
+
+// moveAllElements validates a batch move of keys around anchor, returning the anchor and the pairs to move in map order.
+func (o *OrderedMap[K, V]) moveAllElements(keys []K, anchor K) (*KeyValuePair[K, V], []*KeyValuePair[K, V], error) {
+	mark, ok := o.items[anchor]
+	if !ok {
+		return nil, nil, keyNotFound(anchor)
+	}
+
+	selected := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		if _, ok := o.items[key]; !ok {
+			return nil, nil, keyNotFound(key)
+		}
+		if key == anchor {
+			return nil, nil, duplicateValue(mark.Key, mark.Value)
+		}
+		selected[key] = struct{}{}
+	}
+
+	elements := make([]*KeyValuePair[K, V], 0, len(selected))
+	for e := o.order.Front(); e != nil && len(elements) < len(selected); e = e.Next() {
+		if _, ok := selected[e.Value.Key]; ok {
+			elements = append(elements, e.Value)
+		}
+	}
+	return mark, elements, nil
+}
+
+// MoveToIndex allows for manipulating the order of a map by moving key (and associated value) to the zero-based
+// position index, shifting other pairs as needed.
+//
+// Out of range indices are clamped: a negative index moves key to the front, and an index at or beyond Len moves
+// key to the back. If key does not exist in the map, this will raise a KeyNotFoundError to signal failed intent to
+// the caller.
+func (o *OrderedMap[K, V]) MoveToIndex(key K, index int) error {
+	element, ok := o.items[key]
+	if !ok {
+		return keyNotFound(key)
+	}
+
+	switch current := o.IndexOf(key); {
+	case index <= 0:
+		o.order.MoveToFront(element.element)
+	case index >= o.order.Len()-1:
+		o.order.MoveToBack(element.element)
+	case index > current:
+		mark, _ := o.At(index)
+		o.order.MoveAfter(element.element, mark.element)
+	case index < current:
+		mark, _ := o.At(index)
+		o.order.MoveBefore(element.element, mark.element)
+	default:
+		return nil
+	}
+
+	o.notify(ChangeMove, key, element.Value, element.Value)
+	return nil
+}
+
+// Swap exchanges the positions of the pairs defined at keyA and keyB, leaving their values unchanged.
+//
+// If either element is not found, this will raise a KeyNotFoundError to signal failed intent to the caller.
+func (o *OrderedMap[K, V]) Swap(keyA, keyB K) error {
+	defer o.holdEvents()()
+	a, ok := o.items[keyA]
+	if !ok {
+		return keyNotFound(keyA)
+	}
+	b, ok := o.items[keyB]
+	if !ok {
+		return keyNotFound(keyB)
+	}
+	if a == b {
+		return nil
+	}
+
+	switch aNext := a.element.Next(); {
+	case aNext == b.element:
+		o.order.MoveAfter(a.element, b.element)
+	case b.element.Next() == a.element:
+		o.order.MoveAfter(b.element, a.element)
+	default:
+		o.order.MoveBefore(a.element, b.element)
+		if aNext == nil {
+			o.order.MoveToBack(b.element)
+		} else {
+			o.order.MoveBefore(b.element, aNext)
+		}
+	}
+
+	o.notify(ChangeMove, keyA, a.Value, a.Value)
+	o.notify(ChangeMove, keyB, b.Value, b.Value)
+	return nil
+}
+
+// WouldMoveChange reports whether MoveBefore(key, before) would change the order of the map.
+//
+// This is false if key is already immediately before 'before', or if key and before are the same.
 // If either element is not found, this will raise a KeyNotFoundError to signal failed intent to the caller.
+func (o *OrderedMap[K, V]) WouldMoveChange(key, before K) (bool, error) {
+	if element, ok := o.items[key]; ok {
+		if mark, exists := o.items[before]; exists {
+			return element != mark && element.element.Next() != mark.element, nil
+		}
+
+		return false, keyNotFound(before)
+	}
+
+	return false, keyNotFound(key)
+}
+
+// WouldMoveAfterChange reports whether MoveAfter(key, after) would change the order of the map.
+//
+// This is false if key is already immediately after 'after', or if key and after are the same.
+// If either element is not found, this will raise a KeyNotFoundError to signal failed intent to the caller.
+func (o *OrderedMap[K, V]) WouldMoveAfterChange(key, after K) (bool, error) {
+	if element, ok := o.items[key]; ok {
+		if mark, exists := o.items[after]; exists {
+			return element != mark && element.element.Prev() != mark.element, nil
+		}
+
+		return false, keyNotFound(after)
+	}
+
+	return false, keyNotFound(key)
+}
+
+// InsertAfter allows for manipulating the order of a map by inserting the provided key and value after the pair defined at 'after'.
+//
+// If 'after' is not found, this will raise a KeyNotFoundError for 'after' to signal failed intent to the caller.
 // If key and after are the same or if key already exists, this will raise a DuplicateKeyValueError.
 //
 // This differs from behavior one might expect from container/list in the standard library, because we operate on
@@ -197,17 +839,18 @@ func (o *OrderedMap[K, V]) InsertAfter(key K, value V, after K) error {
 		if key == after {
 			return duplicateValue(mark.Key, mark.Value)
 		}
-		newElement := o.insertKeyValuePair(key, value)
+		newElement := o.pushKeyValuePair(key, value)
 		o.order.MoveAfter(newElement.element, mark.element)
+		o.notify(ChangeInsert, key, *new(V), value)
 		return nil
 	}
 
-	return keyNotFound(key)
+	return keyNotFound(after)
 }
 
 // InsertBefore allows for manipulating the order of a map by inserting the provided key and value before the pair defined at 'before'.
 //
-// If either element is not found, this will raise a KeyNotFoundError to signal failed intent to the caller.
+// If 'before' is not found, this will raise a KeyNotFoundError for 'before' to signal failed intent to the caller.
 // If key and before are the same or if key already exists, this will raise a DuplicateKeyValueError.
 //
 // This differs from behavior one might expect from container/list in the standard library, because we operate on
@@ -220,24 +863,113 @@ func (o *OrderedMap[K, V]) InsertBefore(key K, value V, before K) error {
 		if key == before {
 			return duplicateValue(mark.Key, mark.Value)
 		}
-		newElement := o.insertKeyValuePair(key, value)
+		newElement := o.pushKeyValuePair(key, value)
 		o.order.MoveBefore(newElement.element, mark.element)
+		o.notify(ChangeInsert, key, *new(V), value)
 		return nil
 	}
-	return keyNotFound(key)
+	return keyNotFound(before)
+}
+
+// InsertAt allows for manipulating the order of a map by inserting the provided key and value at the zero-based
+// position index, shifting the pair at that position and all following pairs back by one.
+//
+// Out of range indices are clamped: a negative index inserts at the front, and an index at or beyond Len inserts at
+// the back. If key already exists, this will raise a DuplicateKeyValueError and the map is unmodified.
+func (o *OrderedMap[K, V]) InsertAt(index int, key K, value V) error {
+	if exists, ok := o.items[key]; ok {
+		return duplicateValue(exists.Key, exists.Value)
+	}
+
+	mark, shift := o.At(max(index, 0))
+	newElement := o.pushKeyValuePair(key, value)
+	if shift {
+		o.order.MoveBefore(newElement.element, mark.element)
+	}
+	o.notify(ChangeInsert, key, *new(V), value)
+	return nil
 }
 
-// String fulfils the fmt.Stringer interface
+// String fulfils the fmt.Stringer interface, printing pairs in order such as OrderedMap[string,int]{First=1, Second=2}.
 func (o *OrderedMap[K, V]) String() string {
+	return o.render('v', false)
+}
+
+// Format fulfills the fmt.Formatter interface, allowing control over how the map is printed.
+//
+// The %v and %s verbs print the same as String, and the '+' flag adds the number of pairs. The %#v verb delegates to
+// GoString. Other verbs, such as %x, are applied to each key and value, except %q which only quotes keys and values
+// of a string kind and prints all others as %v. Width pads the whole output, to the left
+// unless the '-' flag is set.
+func (o *OrderedMap[K, V]) Format(f fmt.State, verb rune) {
+	var out string
+	if verb == 'v' && f.Flag('#') {
+		out = o.GoString()
+	} else {
+		if verb == 's' {
+			verb = 'v'
+		}
+		out = o.render(verb, f.Flag('+'))
+	}
+
+	if width, ok := f.Width(); ok && width > len([]rune(out)) {
+		padding := strings.Repeat(" ", width-len([]rune(out)))
+		if f.Flag('-') {
+			out += padding
+		} else {
+			out = padding + out
+		}
+	}
+	_, _ = io.WriteString(f, out)
+}
+
+// render writes the map as String does, formatting each key and value according to verb.
+func (o *OrderedMap[K, V]) render(verb rune, withLen bool) string {
 	buf := bytes.Buffer{}
 	buf.WriteString(fmt.Sprintf("OrderedMap[%T,%T]", *new(K), *new(V)))
-	if o != nil && o.order.Len() > 0 {
-		l := o.order
-		for e := l.Front(); e != nil; e = e.Next() {
-			buf.WriteString(fmt.Sprintf("\t%v=%v,\n", e.Value.Key, e.Value.Value))
+	if withLen {
+		buf.WriteString(fmt.Sprintf("(len=%d)", o.Len()))
+	}
+	buf.WriteByte('{')
+	if o != nil {
+		for e := o.order.Front(); e != nil; e = e.Next() {
+			buf.WriteString(formatItem(verb, e.Value.Key))
+			buf.WriteByte('=')
+			buf.WriteString(formatItem(verb, e.Value.Value))
+			if e.Next() != nil {
+				buf.WriteString(", ")
+			}
+		}
+	}
+	buf.WriteByte('}')
+	return buf.String()
+}
+
+// formatItem formats v according to verb. As %q would otherwise print integers as quoted characters (65 as 'A'),
+// it falls back to %v for values which are not of a string kind.
+func formatItem(verb rune, v any) string {
+	if verb == 'q' && reflect.ValueOf(v).Kind() != reflect.String {
+		verb = 'v'
+	}
+	return fmt.Sprintf("%"+string(verb), v)
+}
+
+// FormatPairs renders each pair in order as keyFn(key)=valFn(value), joining pairs with sep.
+//
+// This allows full control over rendering of keys and values, for example to produce env-file or Redis-style output,
+// without relying on reflection or the fixed format of String.
+//
+// This is named FormatPairs rather than Format because Format is reserved for the fmt.Formatter implementation, whose
+// signature is fixed by the fmt package.
+func (o *OrderedMap[K, V]) FormatPairs(keyFn func(K) string, valFn func(V) string, sep string) string {
+	buf := bytes.Buffer{}
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		buf.WriteString(keyFn(e.Value.Key))
+		buf.WriteString("=")
+		buf.WriteString(valFn(e.Value.Value))
+		if e.Next() != nil {
+			buf.WriteString(sep)
 		}
-	} else {
-		buf.WriteString("{}")
 	}
 	return buf.String()
 }
@@ -270,3 +1002,48 @@ func New[K comparable, V any]() *OrderedMap[K, V] {
 	m.Init()
 	return m
 }
+
+// NewWithCapacity initializes a new OrderedMap with space preallocated for at least capacity keys.
+// The preallocation is discarded if the map is later reset via Init or Clear.
+func NewWithCapacity[K comparable, V any](capacity int) *OrderedMap[K, V] {
+	m := New[K, V]()
+	m.items = make(map[K]*KeyValuePair[K, V], max(capacity, 0))
+	return m
+}
+
+// Of constructs an OrderedMap from pairs, inserted in order.
+// Duplicate keys follow Set semantics: the last value wins, but the key retains the position of its first appearance.
+func Of[K comparable, V any](pairs ...KeyValuePair[K, V]) *OrderedMap[K, V] {
+	return New[K, V]().SetMany(pairs...)
+}
+
+// FromMap constructs an OrderedMap from the contents of m, inserted in the sequence defined by order.
+//
+// If order references a key which does not exist in m, this will raise a KeyNotFoundError.
+// If order references a key more than once, this will raise a DuplicateKeyValueError.
+// If order omits keys which exist in m, this will raise an UnorderedKeysError listing the omitted keys.
+func FromMap[K comparable, V any](m map[K]V, order []K) (*OrderedMap[K, V], error) {
+	o := New[K, V]()
+	for _, key := range order {
+		value, ok := m[key]
+		if !ok {
+			return nil, keyNotFound(key)
+		}
+		if o.Contains(key) {
+			return nil, duplicateValue(key, value)
+		}
+		_ = o.insertKeyValuePair(key, value)
+	}
+
+	if o.Len() != len(m) {
+		omitted := make([]K, 0, len(m)-o.Len())
+		for key := range m {
+			if !o.Contains(key) {
+				omitted = append(omitted, key)
+			}
+		}
+		return nil, &UnorderedKeysError[K]{Keys: omitted}
+	}
+
+	return o, nil
+}
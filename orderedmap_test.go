@@ -1,10 +1,12 @@
 package orderedmap
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
-
-	"github.com/jimschubert/ordered-map/internal/myers"
 )
 
 func ptr[K any](input K) *K {
@@ -42,7 +44,7 @@ func (k *pair[K, V]) Equals(other *KeyValuePair[K, V]) bool {
 	if kValue != nil && otherValue == nil {
 		return false
 	}
-	return reflect.DeepEqual(kValue, otherValue)
+	return valuesEqual(kValue, otherValue)
 }
 
 func newFromPairs[K comparable, V any](item ...*pair[K, V]) *OrderedMap[K, V] {
@@ -70,13 +72,53 @@ func TestNew(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := New[string, int](); !reflect.DeepEqual(got, tt.want) {
+			// Equal rather than reflect.DeepEqual, which would also compare the list's modification count
+			if got := New[string, int](); got.items == nil || !Equal(got, tt.want) {
 				t.Errorf("New() = %#v, want %#v", got, tt.want)
 			}
 		})
 	}
 }
 
+func TestNewWithCapacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+	}{
+		{name: "zero capacity", capacity: 0},
+		{name: "negative capacity", capacity: -1},
+		{name: "positive capacity", capacity: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewWithCapacity[string, int](tt.capacity)
+			compareOrderedMaps(t, New[string, int](), got)
+
+			got.Set("a", 1).Set("b", 2)
+			compareOrderedMaps(t, newFromPairs(kvp("a", 1), kvp("b", 2)), got)
+		})
+	}
+}
+
+func benchmarkBulkLoad(b *testing.B, newMap func(int) *OrderedMap[int, int]) {
+	const size = 50_000
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		m := newMap(size)
+		for j := 0; j < size; j++ {
+			m.Set(j, j)
+		}
+	}
+}
+
+func BenchmarkNew_bulkLoad(b *testing.B) {
+	benchmarkBulkLoad(b, func(int) *OrderedMap[int, int] { return New[int, int]() })
+}
+
+func BenchmarkNewWithCapacity_bulkLoad(b *testing.B) {
+	benchmarkBulkLoad(b, NewWithCapacity[int, int])
+}
+
 func TestOrderedMap_First(t *testing.T) {
 	type testCase struct {
 		name string
@@ -221,6 +263,116 @@ func TestOrderedMap_GetOrDefault(t *testing.T) {
 	}
 }
 
+func TestOrderedMap_SetIfAbsent(t *testing.T) {
+	type testCase struct {
+		name         string
+		o            *OrderedMap[string, string]
+		key          string
+		value        string
+		want         string
+		wantInserted bool
+		expect       *OrderedMap[string, string]
+	}
+	tests := []testCase{
+		{
+			name:         "inserts into empty map",
+			o:            New[string, string](),
+			key:          "first",
+			value:        "1st",
+			want:         "1st",
+			wantInserted: true,
+			expect:       newFromPairs(kvp("first", "1st")),
+		},
+		{
+			name:         "appends missing key to back",
+			o:            newFromPairs(kvp("first", "1st"), kvp("second", "2nd")),
+			key:          "third",
+			value:        "3rd",
+			want:         "3rd",
+			wantInserted: true,
+			expect:       newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+		},
+		{
+			name:         "existing key is left unchanged",
+			o:            newFromPairs(kvp("first", "1st"), kvp("second", "2nd")),
+			key:          "first",
+			value:        ":(",
+			want:         "1st",
+			wantInserted: false,
+			expect:       newFromPairs(kvp("first", "1st"), kvp("second", "2nd")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, inserted := tt.o.SetIfAbsent(tt.key, tt.value)
+			if got != tt.want {
+				t.Errorf("SetIfAbsent() got = %v, want %v", got, tt.want)
+			}
+			if inserted != tt.wantInserted {
+				t.Errorf("SetIfAbsent() inserted = %v, want %v", inserted, tt.wantInserted)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
+func TestOrderedMap_ComputeIfAbsent(t *testing.T) {
+	type testCase struct {
+		name       string
+		o          *OrderedMap[string, string]
+		key        string
+		value      string
+		want       string
+		wantCalled bool
+		expect     *OrderedMap[string, string]
+	}
+	tests := []testCase{
+		{
+			name:       "computes into empty map",
+			o:          New[string, string](),
+			key:        "first",
+			value:      "1st",
+			want:       "1st",
+			wantCalled: true,
+			expect:     newFromPairs(kvp("first", "1st")),
+		},
+		{
+			name:       "appends missing key to back",
+			o:          newFromPairs(kvp("first", "1st"), kvp("second", "2nd")),
+			key:        "third",
+			value:      "3rd",
+			want:       "3rd",
+			wantCalled: true,
+			expect:     newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+		},
+		{
+			name:       "existing key does not invoke factory",
+			o:          newFromPairs(kvp("first", "1st"), kvp("second", "2nd")),
+			key:        "second",
+			value:      ":(",
+			want:       "2nd",
+			wantCalled: false,
+			expect:     newFromPairs(kvp("first", "1st"), kvp("second", "2nd")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			got := tt.o.ComputeIfAbsent(tt.key, func() string {
+				called = true
+				return tt.value
+			})
+			if got != tt.want {
+				t.Errorf("ComputeIfAbsent() = %v, want %v", got, tt.want)
+			}
+			if called != tt.wantCalled {
+				t.Errorf("ComputeIfAbsent() called factory = %v, want %v", called, tt.wantCalled)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
 func TestOrderedMap_Init(t *testing.T) {
 	type testCase struct {
 		name string
@@ -236,7 +388,8 @@ func TestOrderedMap_Init(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.o.Init(); !reflect.DeepEqual(got, tt.want) {
+			// Equal rather than reflect.DeepEqual, which would also compare the list's modification count
+			if got := tt.o.Init(); got.items == nil || !Equal(got, tt.want) {
 				t.Errorf("Init() = %#v, want %#v", got, tt.want)
 			}
 		})
@@ -314,6 +467,14 @@ func TestOrderedMap_InsertAfter(t *testing.T) {
 			compareOrderedMaps(t, tt.expect, tt.o)
 		})
 	}
+
+	t.Run("error references the missing 'after' key", func(t *testing.T) {
+		err := newFromPairs(kvp("first", "1st")).InsertAfter("second", "2nd", "missing")
+		var notFound *KeyNotFoundError[string]
+		if !errors.As(err, &notFound) || notFound.Key != "missing" {
+			t.Errorf("InsertAfter() error = %v, want KeyNotFoundError for %v", err, "missing")
+		}
+	})
 }
 
 func TestOrderedMap_InsertBefore(t *testing.T) {
@@ -386,6 +547,14 @@ func TestOrderedMap_InsertBefore(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("error references the missing 'before' key", func(t *testing.T) {
+		err := newFromPairs(kvp(1, "1st")).InsertBefore(2, "2nd", 3)
+		var notFound *KeyNotFoundError[int]
+		if !errors.As(err, &notFound) || notFound.Key != 3 {
+			t.Errorf("InsertBefore() error = %v, want KeyNotFoundError for %v", err, 3)
+		}
+	})
 }
 
 func TestOrderedMap_Last(t *testing.T) {
@@ -430,6 +599,72 @@ func TestOrderedMap_Last(t *testing.T) {
 	}
 }
 
+func TestOrderedMap_InsertAt(t *testing.T) {
+	type testCase struct {
+		name    string
+		index   int
+		key     string
+		wantErr error
+		expect  *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "index 0 inserts at the front",
+			index:  0,
+			key:    "x",
+			expect: newFromPairs(kvp("x", 0), kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:   "middle index shifts later pairs back",
+			index:  2,
+			key:    "x",
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2), kvp("x", 0), kvp("c", 3)),
+		},
+		{
+			name:   "index Len inserts at the back",
+			index:  3,
+			key:    "x",
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("x", 0)),
+		},
+		{
+			name:   "negative index is clamped to the front",
+			index:  -1,
+			key:    "x",
+			expect: newFromPairs(kvp("x", 0), kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:   "index beyond Len is clamped to the back",
+			index:  10,
+			key:    "x",
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("x", 0)),
+		},
+		{
+			name:    "errors if key already exists",
+			index:   0,
+			key:     "b",
+			wantErr: ErrDuplicateKeyValue,
+			expect:  newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3))
+			if err := o.InsertAt(tt.index, tt.key, 0); !errors.Is(err, tt.wantErr) {
+				t.Errorf("InsertAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			compareOrderedMaps(t, tt.expect, o)
+		})
+	}
+
+	t.Run("inserts into an empty map", func(t *testing.T) {
+		o := New[string, int]()
+		if err := o.InsertAt(0, "x", 0); err != nil {
+			t.Fatalf("InsertAt() error = %v", err)
+		}
+		compareOrderedMaps(t, newFromPairs(kvp("x", 0)), o)
+	})
+}
+
 func TestOrderedMap_MoveAfter(t *testing.T) {
 	type testCase struct {
 		name    string
@@ -488,6 +723,80 @@ func TestOrderedMap_MoveAfter(t *testing.T) {
 	}
 }
 
+func TestOrderedMap_MoveAllBeforeAndAfter(t *testing.T) {
+	abcde := func() *OrderedMap[string, int] {
+		return newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4), kvp("e", 5))
+	}
+	type testCase struct {
+		name       string
+		keys       []string
+		anchor     string
+		wantBefore *OrderedMap[string, int]
+		wantAfter  *OrderedMap[string, int]
+		wantErr    error
+	}
+	tests := []testCase{
+		{
+			name:       "moves keys as a block in map order",
+			keys:       []string{"e", "a"},
+			anchor:     "c",
+			wantBefore: newFromPairs(kvp("b", 2), kvp("a", 1), kvp("e", 5), kvp("c", 3), kvp("d", 4)),
+			wantAfter:  newFromPairs(kvp("b", 2), kvp("c", 3), kvp("a", 1), kvp("e", 5), kvp("d", 4)),
+		},
+		{
+			name:       "repeated keys move once",
+			keys:       []string{"b", "d", "b"},
+			anchor:     "e",
+			wantBefore: newFromPairs(kvp("a", 1), kvp("c", 3), kvp("b", 2), kvp("d", 4), kvp("e", 5)),
+			wantAfter:  newFromPairs(kvp("a", 1), kvp("c", 3), kvp("e", 5), kvp("b", 2), kvp("d", 4)),
+		},
+		{
+			name:       "no keys is a no-op",
+			anchor:     "a",
+			wantBefore: abcde(),
+			wantAfter:  abcde(),
+		},
+		{
+			name:    "missing key fails without partial moves",
+			keys:    []string{"a", "z"},
+			anchor:  "c",
+			wantErr: ErrKeyNotFound,
+		},
+		{
+			name:    "missing anchor",
+			keys:    []string{"a"},
+			anchor:  "z",
+			wantErr: ErrKeyNotFound,
+		},
+		{
+			name:    "anchor among keys",
+			keys:    []string{"a", "c"},
+			anchor:  "c",
+			wantErr: ErrDuplicateKeyValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := abcde()
+			err := o.MoveBeforeAll(tt.keys, tt.anchor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MoveBeforeAll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				tt.wantBefore, tt.wantAfter = abcde(), abcde()
+			}
+			compareOrderedMaps(t, tt.wantBefore, o)
+
+			o = abcde()
+			err = o.MoveAfterAll(tt.keys, tt.anchor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MoveAfterAll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			compareOrderedMaps(t, tt.wantAfter, o)
+		})
+	}
+}
+
 func TestOrderedMap_MoveBefore(t *testing.T) {
 	type testCase struct {
 		name    string
@@ -650,6 +959,203 @@ func TestOrderedMap_MoveToFront(t *testing.T) {
 	}
 }
 
+func TestOrderedMap_MoveIfExists(t *testing.T) {
+	type testCase struct {
+		name        string
+		key         string
+		wantFront   *OrderedMap[string, int]
+		wantBack    *OrderedMap[string, int]
+		wantPresent bool
+	}
+	tests := []testCase{
+		{
+			name:        "moves an existing key",
+			key:         "b",
+			wantFront:   newFromPairs(kvp("b", 2), kvp("a", 1), kvp("c", 3)),
+			wantBack:    newFromPairs(kvp("a", 1), kvp("c", 3), kvp("b", 2)),
+			wantPresent: true,
+		},
+		{
+			name:      "missing key is a no-op",
+			key:       "z",
+			wantFront: newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			wantBack:  newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3))
+			if got := o.MoveToFrontIfExists(tt.key); got != tt.wantPresent {
+				t.Errorf("MoveToFrontIfExists() = %v, want %v", got, tt.wantPresent)
+			}
+			compareOrderedMaps(t, tt.wantFront, o)
+
+			o = newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3))
+			if got := o.MoveToBackIfExists(tt.key); got != tt.wantPresent {
+				t.Errorf("MoveToBackIfExists() = %v, want %v", got, tt.wantPresent)
+			}
+			compareOrderedMaps(t, tt.wantBack, o)
+		})
+	}
+}
+
+func TestOrderedMap_MoveToIndex(t *testing.T) {
+	type testCase struct {
+		name    string
+		o       *OrderedMap[string, int]
+		key     string
+		index   int
+		expect  *OrderedMap[string, int]
+		wantErr error
+	}
+	tests := []testCase{
+		{
+			name:   "move forward",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			key:    "a",
+			index:  2,
+			expect: newFromPairs(kvp("b", 2), kvp("c", 3), kvp("a", 1), kvp("d", 4)),
+		},
+		{
+			name:   "move backward",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			key:    "d",
+			index:  1,
+			expect: newFromPairs(kvp("a", 1), kvp("d", 4), kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:   "move to current index",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			key:    "b",
+			index:  1,
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:   "move to front",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			key:    "c",
+			index:  0,
+			expect: newFromPairs(kvp("c", 3), kvp("a", 1), kvp("b", 2)),
+		},
+		{
+			name:   "move to back",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			key:    "a",
+			index:  2,
+			expect: newFromPairs(kvp("b", 2), kvp("c", 3), kvp("a", 1)),
+		},
+		{
+			name:   "negative index clamps to front",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			key:    "b",
+			index:  -5,
+			expect: newFromPairs(kvp("b", 2), kvp("a", 1), kvp("c", 3)),
+		},
+		{
+			name:   "index beyond length clamps to back",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			key:    "b",
+			index:  10,
+			expect: newFromPairs(kvp("a", 1), kvp("c", 3), kvp("b", 2)),
+		},
+		{
+			name:    "missing key",
+			o:       newFromPairs(kvp("a", 1), kvp("b", 2)),
+			key:     "z",
+			index:   0,
+			expect:  newFromPairs(kvp("a", 1), kvp("b", 2)),
+			wantErr: keyNotFound("z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.o.MoveToIndex(tt.key, tt.index); !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("MoveToIndex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
+func TestOrderedMap_Swap(t *testing.T) {
+	type testCase struct {
+		name    string
+		o       *OrderedMap[string, int]
+		keyA    string
+		keyB    string
+		expect  *OrderedMap[string, int]
+		wantErr error
+	}
+	tests := []testCase{
+		{
+			name:   "swap adjacent keys",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			keyA:   "a",
+			keyB:   "b",
+			expect: newFromPairs(kvp("b", 2), kvp("a", 1), kvp("c", 3)),
+		},
+		{
+			name:   "swap adjacent keys in reverse",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			keyA:   "c",
+			keyB:   "b",
+			expect: newFromPairs(kvp("a", 1), kvp("c", 3), kvp("b", 2)),
+		},
+		{
+			name:   "swap front and back",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			keyA:   "a",
+			keyB:   "d",
+			expect: newFromPairs(kvp("d", 4), kvp("b", 2), kvp("c", 3), kvp("a", 1)),
+		},
+		{
+			name:   "swap back and front",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			keyA:   "d",
+			keyB:   "a",
+			expect: newFromPairs(kvp("d", 4), kvp("b", 2), kvp("c", 3), kvp("a", 1)),
+		},
+		{
+			name:   "swap inner keys",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4), kvp("e", 5)),
+			keyA:   "b",
+			keyB:   "d",
+			expect: newFromPairs(kvp("a", 1), kvp("d", 4), kvp("c", 3), kvp("b", 2), kvp("e", 5)),
+		},
+		{
+			name:   "swap key with itself",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2)),
+			keyA:   "a",
+			keyB:   "a",
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2)),
+		},
+		{
+			name:    "missing first key",
+			o:       newFromPairs(kvp("a", 1), kvp("b", 2)),
+			keyA:    "z",
+			keyB:    "a",
+			expect:  newFromPairs(kvp("a", 1), kvp("b", 2)),
+			wantErr: keyNotFound("z"),
+		},
+		{
+			name:    "missing second key",
+			o:       newFromPairs(kvp("a", 1), kvp("b", 2)),
+			keyA:    "a",
+			keyB:    "z",
+			expect:  newFromPairs(kvp("a", 1), kvp("b", 2)),
+			wantErr: keyNotFound("z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.o.Swap(tt.keyA, tt.keyB); !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Swap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
 func TestOrderedMap_Remove(t *testing.T) {
 	type testCase struct {
 		name   string
@@ -745,15 +1251,98 @@ func TestOrderedMap_Set(t *testing.T) {
 	}
 }
 
+func TestOrderedMap_GetAndSet(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, string]
+		key    string
+		value  string
+		want   *string
+		wantOk bool
+		expect *OrderedMap[string, string]
+	}
+	tests := []testCase{
+		{
+			name:   "new key is appended",
+			o:      newFromPairs(kvp("first", "1st")),
+			key:    "second",
+			value:  "2nd",
+			want:   nil,
+			wantOk: false,
+			expect: newFromPairs(kvp("first", "1st"), kvp("second", "2nd")),
+		},
+		{
+			name:   "existing key returns previous value without changing order",
+			o:      newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+			key:    "second",
+			value:  ":(",
+			want:   ptr("2nd"),
+			wantOk: true,
+			expect: newFromPairs(kvp("first", "1st"), kvp("second", ":("), kvp("third", "3rd")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.o.GetAndSet(tt.key, tt.value)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetAndSet() got = %v, want %v", got, tt.want)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("GetAndSet() ok = %v, want %v", ok, tt.wantOk)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
+func TestOrderedMap_SetMany(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, string]
+		pairs  []KeyValuePair[string, string]
+		expect *OrderedMap[string, string]
+	}
+	tests := []testCase{
+		{
+			name:   "no pairs leaves map unchanged",
+			o:      newFromPairs(kvp("first", "1st")),
+			pairs:  nil,
+			expect: newFromPairs(kvp("first", "1st")),
+		},
+		{
+			name:   "pairs are appended in order",
+			o:      newFromPairs(kvp("first", "1st")),
+			pairs:  []KeyValuePair[string, string]{{Key: "third", Value: "3rd"}, {Key: "second", Value: "2nd"}},
+			expect: newFromPairs(kvp("first", "1st"), kvp("third", "3rd"), kvp("second", "2nd")),
+		},
+		{
+			name:   "existing keys are updated without changing order",
+			o:      newFromPairs(kvp("first", "1st"), kvp("second", "2nd")),
+			pairs:  []KeyValuePair[string, string]{{Key: "third", Value: "3rd"}, {Key: "first", Value: ":("}},
+			expect: newFromPairs(kvp("first", ":("), kvp("second", "2nd"), kvp("third", "3rd")),
+		},
+		{
+			name:   "repeated keys keep the last value",
+			o:      New[string, string](),
+			pairs:  []KeyValuePair[string, string]{{Key: "first", Value: "1st"}, {Key: "first", Value: "one"}},
+			expect: newFromPairs(kvp("first", "one")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.SetMany(tt.pairs...); got != tt.o {
+				t.Errorf("SetMany() did not return the receiver")
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
 func compareOrderedMaps[K comparable, T any](t *testing.T, left *OrderedMap[K, T], right *OrderedMap[K, T]) {
 	t.Helper()
 
-	if !Equal(left, right) {
-		if diff, ok := myers.Diff(left.GoString(), right.GoString()); ok {
-			t.Errorf("Expected state mismatch:\n%s\n", diff)
-		} else {
-			t.Errorf("Expected state mismatch:\nwanted:\n%s\ngot:\n%s\n", left.GoString(), right.GoString())
-		}
+	if diff, ok := DiffString(left, right); ok {
+		t.Errorf("Expected state mismatch:\n%s\n", diff)
 	}
 }
 
@@ -803,3 +1392,1484 @@ func TestOrderedMap_Keys(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderedMap_String(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, int]
+		want string
+	}
+	tests := []testCase{
+		{
+			name: "nil map",
+			o:    nil,
+			want: "OrderedMap[string,int]{}",
+		},
+		{
+			name: "empty map",
+			o:    New[string, int](),
+			want: "OrderedMap[string,int]{}",
+		},
+		{
+			name: "single element map",
+			o:    newFromPairs(kvp("First", 1)),
+			want: "OrderedMap[string,int]{First=1}",
+		},
+		{
+			name: "multiple element map in order",
+			o:    newFromPairs(kvp("Second", 2), kvp("First", 1), kvp("Third", 3)),
+			want: "OrderedMap[string,int]{Second=2, First=1, Third=3}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Format(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, string]
+		format string
+		want   string
+	}
+	populated := newFromPairs(kvp("First", "1st"), kvp("Second", "2nd"))
+	tests := []testCase{
+		{
+			name:   "%v matches String",
+			o:      populated,
+			format: "%v",
+			want:   populated.String(),
+		},
+		{
+			name:   "%s matches String",
+			o:      populated,
+			format: "%s",
+			want:   populated.String(),
+		},
+		{
+			name:   "%v of an empty map",
+			o:      New[string, string](),
+			format: "%v",
+			want:   "OrderedMap[string,string]{}",
+		},
+		{
+			name:   "%+v includes the length",
+			o:      populated,
+			format: "%+v",
+			want:   "OrderedMap[string,string](len=2){First=1st, Second=2nd}",
+		},
+		{
+			name:   "%+v of a nil map",
+			o:      nil,
+			format: "%+v",
+			want:   "OrderedMap[string,string](len=0){}",
+		},
+		{
+			name:   "%#v delegates to GoString",
+			o:      populated,
+			format: "%#v",
+			want:   populated.GoString(),
+		},
+		{
+			name:   "%q quotes keys and values",
+			o:      populated,
+			format: "%q",
+			want:   "OrderedMap[string,string]{\"First\"=\"1st\", \"Second\"=\"2nd\"}",
+		},
+		{
+			name:   "width pads to the left",
+			o:      New[string, string](),
+			format: "%30v|",
+			want:   "   OrderedMap[string,string]{}|",
+		},
+		{
+			name:   "width with '-' pads to the right",
+			o:      New[string, string](),
+			format: "%-30v|",
+			want:   "OrderedMap[string,string]{}   |",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.o); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("%q quotes only string kinds", func(t *testing.T) {
+		type name string
+		o := New[name, any]().Set("k", 65).Set("s", "str").Set("n", nil).Set("t", name("typed"))
+		want := "{\"k\"=65, \"s\"=\"str\", \"n\"=<nil>, \"t\"=\"typed\"}"
+		if got := fmt.Sprintf("%q", o); !strings.HasSuffix(got, want) {
+			t.Errorf("Sprintf(%q) = %q, want suffix %q", "%q", got, want)
+		}
+
+		ints := New[int, int]().Set(66, 65)
+		if got, want := fmt.Sprintf("%q", ints), "OrderedMap[int,int]{66=65}"; got != want {
+			t.Errorf("Sprintf(%q) = %q, want %q", "%q", got, want)
+		}
+	})
+}
+
+func TestOrderedMap_FormatPairs(t *testing.T) {
+	type testCase struct {
+		name  string
+		o     *OrderedMap[string, int]
+		keyFn func(string) string
+		valFn func(int) string
+		sep   string
+		want  string
+	}
+	tests := []testCase{
+		{
+			name:  "empty map yields empty string",
+			o:     New[string, int](),
+			keyFn: func(k string) string { return k },
+			valFn: strconv.Itoa,
+			sep:   "\n",
+			want:  "",
+		},
+		{
+			name:  "env-file style output",
+			o:     newFromPairs(kvp("home", 1), kvp("path", 2), kvp("shell", 3)),
+			keyFn: strings.ToUpper,
+			valFn: strconv.Itoa,
+			sep:   "\n",
+			want:  "HOME=1\nPATH=2\nSHELL=3",
+		},
+		{
+			name: "custom separator follows map order after manipulation",
+			o: func() *OrderedMap[string, int] {
+				m := newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3))
+				_ = m.MoveToFront("c")
+				return m
+			}(),
+			keyFn: func(k string) string { return k },
+			valFn: func(v int) string { return fmt.Sprintf("%q", strconv.Itoa(v)) },
+			sep:   " ",
+			want:  `c="3" a="1" b="2"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.o.FormatPairs(tt.keyFn, tt.valFn, tt.sep)
+			if got != tt.want {
+				t.Errorf("FormatPairs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_WouldMoveChange(t *testing.T) {
+	type testCase struct {
+		name    string
+		o       *OrderedMap[string, string]
+		key     string
+		before  string
+		want    bool
+		wantErr bool
+	}
+	tests := []testCase{
+		{
+			name:   "already immediately before is a no-op",
+			o:      newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+			key:    "first",
+			before: "second",
+			want:   false,
+		},
+		{
+			name:   "same key is a no-op",
+			o:      newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+			key:    "second",
+			before: "second",
+			want:   false,
+		},
+		{
+			name:   "key after 'before' would change",
+			o:      newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+			key:    "second",
+			before: "first",
+			want:   true,
+		},
+		{
+			name:   "key two positions before would change",
+			o:      newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+			key:    "first",
+			before: "third",
+			want:   true,
+		},
+		{
+			name:    "errors on missing key",
+			o:       newFromPairs(kvp("first", "1st")),
+			key:     "asdf",
+			before:  "first",
+			wantErr: true,
+		},
+		{
+			name:    "errors on missing 'before'",
+			o:       newFromPairs(kvp("first", "1st")),
+			key:     "first",
+			before:  "asdf",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.WouldMoveChange(tt.key, tt.before)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WouldMoveChange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("WouldMoveChange() = %v, want %v", got, tt.want)
+			}
+
+			if !tt.wantErr {
+				original := tt.o.GoString()
+				_ = tt.o.MoveBefore(tt.key, tt.before)
+				if changed := original != tt.o.GoString(); changed != tt.want {
+					t.Errorf("WouldMoveChange() = %v, but MoveBefore changed order: %v", tt.want, changed)
+				}
+			}
+		})
+	}
+}
+
+func TestOrderedMap_WouldMoveAfterChange(t *testing.T) {
+	type testCase struct {
+		name    string
+		o       *OrderedMap[string, string]
+		key     string
+		after   string
+		want    bool
+		wantErr bool
+	}
+	tests := []testCase{
+		{
+			name:  "already immediately after is a no-op",
+			o:     newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+			key:   "second",
+			after: "first",
+			want:  false,
+		},
+		{
+			name:  "same key is a no-op",
+			o:     newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+			key:   "third",
+			after: "third",
+			want:  false,
+		},
+		{
+			name:  "key before 'after' would change",
+			o:     newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+			key:   "first",
+			after: "second",
+			want:  true,
+		},
+		{
+			name:    "errors on missing 'after'",
+			o:       newFromPairs(kvp("first", "1st")),
+			key:     "first",
+			after:   "asdf",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.WouldMoveAfterChange(tt.key, tt.after)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WouldMoveAfterChange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("WouldMoveAfterChange() = %v, want %v", got, tt.want)
+			}
+
+			if !tt.wantErr {
+				original := tt.o.GoString()
+				_ = tt.o.MoveAfter(tt.key, tt.after)
+				if changed := original != tt.o.GoString(); changed != tt.want {
+					t.Errorf("WouldMoveAfterChange() = %v, but MoveAfter changed order: %v", tt.want, changed)
+				}
+			}
+		})
+	}
+}
+
+func TestOrderedMap_ReplaceAll(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		src    *OrderedMap[string, int]
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "old contents are fully replaced",
+			o:      newFromPairs(kvp("old1", 1), kvp("shared", 2), kvp("old3", 3)),
+			src:    newFromPairs(kvp("new1", 10), kvp("shared", 20)),
+			expect: newFromPairs(kvp("new1", 10), kvp("shared", 20)),
+		},
+		{
+			name:   "empty map receives contents",
+			o:      New[string, int](),
+			src:    newFromPairs(kvp("a", 1), kvp("b", 2)),
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2)),
+		},
+		{
+			name:   "nil source clears the map",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2)),
+			src:    nil,
+			expect: New[string, int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.o.ReplaceAll(tt.src)
+			compareOrderedMaps(t, tt.expect, tt.o)
+
+			if tt.src != nil {
+				// the source must remain independent of the target
+				_ = tt.o.Set("mutated", 100)
+				if _, ok := tt.src.Get("mutated"); ok {
+					t.Errorf("ReplaceAll() target shares state with source")
+				}
+			}
+		})
+	}
+
+	t.Run("replacing with itself is a no-op", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1), kvp("b", 2))
+		o.ReplaceAll(o)
+		compareOrderedMaps(t, newFromPairs(kvp("a", 1), kvp("b", 2)), o)
+	})
+}
+
+func TestOrderedMap_Len(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, int]
+		want int
+	}
+	tests := []testCase{
+		{
+			name: "nil map has zero length",
+			o:    nil,
+			want: 0,
+		},
+		{
+			name: "empty map has zero length",
+			o:    New[string, int](),
+			want: 0,
+		},
+		{
+			name: "populated map",
+			o:    newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			want: 3,
+		},
+		{
+			name: "length reflects removal",
+			o: func() *OrderedMap[string, int] {
+				m := newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3))
+				m.Remove("two")
+				return m
+			}(),
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Len(); got != tt.want {
+				t.Errorf("Len() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Values(t *testing.T) {
+	type testCase struct {
+		name  string
+		o     *OrderedMap[string, int]
+		want  []int
+		manip func(o *OrderedMap[string, int])
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields empty values",
+			o:    New[string, int](),
+			want: []int{},
+		},
+		{
+			name: "multiple value map yields correct order",
+			o:    newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3), kvp("four", 4)),
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name: "values should not be cached and returned with original order after map manipulation",
+			o:    newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3), kvp("four", 4)),
+			manip: func(o *OrderedMap[string, int]) {
+				if err := o.InsertAfter("zero", 0, "four"); err != nil {
+					t.Fatalf("Values(): failed to manipulate map: %v", err)
+				}
+			},
+			want: []int{1, 2, 3, 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.o.Values()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Values() = %v, want %v", got, tt.want)
+			}
+
+			if tt.manip != nil {
+				tt.manip(tt.o)
+				got = tt.o.Values()
+				if reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Values() manipulated map should not yield the same values: %v", got)
+				}
+			}
+		})
+	}
+}
+
+func TestOrderedMap_AppendKeysAndValues(t *testing.T) {
+	type testCase struct {
+		name       string
+		o          *OrderedMap[string, int]
+		keys       []string
+		values     []int
+		wantKeys   []string
+		wantValues []int
+	}
+	tests := []testCase{
+		{
+			name:       "empty map leaves nil dst unchanged",
+			o:          New[string, int](),
+			wantKeys:   nil,
+			wantValues: nil,
+		},
+		{
+			name:       "appends in map order",
+			o:          newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			wantKeys:   []string{"one", "two", "three"},
+			wantValues: []int{1, 2, 3},
+		},
+		{
+			name:       "appends after existing elements",
+			o:          newFromPairs(kvp("one", 1), kvp("two", 2)),
+			keys:       []string{"zero"},
+			values:     []int{0},
+			wantKeys:   []string{"zero", "one", "two"},
+			wantValues: []int{0, 1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.AppendKeys(tt.keys); !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("AppendKeys() = %v, want %v", got, tt.wantKeys)
+			}
+			if got := tt.o.AppendValues(tt.values); !reflect.DeepEqual(got, tt.wantValues) {
+				t.Errorf("AppendValues() = %v, want %v", got, tt.wantValues)
+			}
+		})
+	}
+
+	t.Run("reuses the backing array", func(t *testing.T) {
+		o := newFromPairs(kvp("one", 1), kvp("two", 2))
+		buf := make([]string, 0, 4)
+		got := o.AppendKeys(buf[:0])
+		if &got[0] != &buf[:1][0] {
+			t.Errorf("AppendKeys() allocated a new backing array despite sufficient capacity")
+		}
+	})
+}
+
+func TestOrderedMap_Pairs(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, int]
+		want []KeyValuePair[string, int]
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields empty pairs",
+			o:    New[string, int](),
+			want: []KeyValuePair[string, int]{},
+		},
+		{
+			name: "pairs follow map order",
+			o: func() *OrderedMap[string, int] {
+				m := newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3))
+				_ = m.MoveToBack("one")
+				return m
+			}(),
+			want: []KeyValuePair[string, int]{{Key: "two", Value: 2}, {Key: "three", Value: 3}, {Key: "one", Value: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.o.Pairs()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Pairs() = %v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				got[i].Value = -1
+			}
+			for _, v := range tt.o.Values() {
+				if v == -1 {
+					t.Errorf("Pairs() modifying a returned pair modified the map: %v", tt.o)
+				}
+			}
+		})
+	}
+}
+
+func TestOrderedMap_FirstN(t *testing.T) {
+	type testCase struct {
+		name      string
+		o         *OrderedMap[string, int]
+		n         int
+		wantFirst []KeyValuePair[string, int]
+		wantLast  []KeyValuePair[string, int]
+	}
+	tests := []testCase{
+		{
+			name:      "empty map",
+			o:         New[string, int](),
+			n:         2,
+			wantFirst: []KeyValuePair[string, int]{},
+			wantLast:  []KeyValuePair[string, int]{},
+		},
+		{
+			name:      "fewer than length",
+			o:         newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			n:         2,
+			wantFirst: []KeyValuePair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+			wantLast:  []KeyValuePair[string, int]{{Key: "c", Value: 3}, {Key: "d", Value: 4}},
+		},
+		{
+			name:      "more than length",
+			o:         newFromPairs(kvp("a", 1), kvp("b", 2)),
+			n:         5,
+			wantFirst: []KeyValuePair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+			wantLast:  []KeyValuePair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+		},
+		{
+			name:      "zero",
+			o:         newFromPairs(kvp("a", 1), kvp("b", 2)),
+			n:         0,
+			wantFirst: []KeyValuePair[string, int]{},
+			wantLast:  []KeyValuePair[string, int]{},
+		},
+		{
+			name:      "negative",
+			o:         newFromPairs(kvp("a", 1), kvp("b", 2)),
+			n:         -1,
+			wantFirst: []KeyValuePair[string, int]{},
+			wantLast:  []KeyValuePair[string, int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.FirstN(tt.n); !reflect.DeepEqual(got, tt.wantFirst) {
+				t.Errorf("FirstN() = %v, want %v", got, tt.wantFirst)
+			}
+			if got := tt.o.LastN(tt.n); !reflect.DeepEqual(got, tt.wantLast) {
+				t.Errorf("LastN() = %v, want %v", got, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Clone(t *testing.T) {
+	t.Run("nil map clones to nil", func(t *testing.T) {
+		var o *OrderedMap[string, int]
+		if got := o.Clone(); got != nil {
+			t.Errorf("Clone() = %#v, want nil", got)
+		}
+	})
+
+	t.Run("clone has the same contents and order", func(t *testing.T) {
+		o := newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3))
+		_ = o.MoveToFront("three")
+		compareOrderedMaps(t, o, o.Clone())
+	})
+
+	t.Run("clone is independent of the original", func(t *testing.T) {
+		o := newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3))
+		clone := o.Clone()
+
+		_ = clone.MoveToBack("one")
+		clone.Set("two", 20)
+		_ = clone.InsertBefore("zero", 0, "two")
+		compareOrderedMaps(t, newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)), o)
+
+		o.Remove("three")
+		compareOrderedMaps(t, newFromPairs(kvp("zero", 0), kvp("two", 20), kvp("three", 3), kvp("one", 1)), clone)
+	})
+}
+
+func TestOrderedMap_Contains(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, int]
+		key  string
+		want bool
+	}
+	tests := []testCase{
+		{
+			name: "empty map contains nothing",
+			o:    New[string, int](),
+			key:  "one",
+			want: false,
+		},
+		{
+			name: "contains existing key",
+			o:    newFromPairs(kvp("one", 1), kvp("two", 2)),
+			key:  "two",
+			want: true,
+		},
+		{
+			name: "contains key with zero value",
+			o:    newFromPairs(kvp("zero", 0)),
+			key:  "zero",
+			want: true,
+		},
+		{
+			name: "does not contain missing key",
+			o:    newFromPairs(kvp("one", 1), kvp("two", 2)),
+			key:  "three",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Contains(tt.key); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_FindByValue(t *testing.T) {
+	type testCase struct {
+		name    string
+		o       *OrderedMap[string, []int]
+		v       []int
+		wantKey string
+		wantOk  bool
+	}
+	eq := func(a, b []int) bool { return reflect.DeepEqual(a, b) }
+	tests := []testCase{
+		{
+			name:    "empty map",
+			o:       New[string, []int](),
+			v:       []int{1},
+			wantKey: "",
+			wantOk:  false,
+		},
+		{
+			name:    "value not found",
+			o:       newFromPairs(kvp("a", []int{1}), kvp("b", []int{2})),
+			v:       []int{3},
+			wantKey: "",
+			wantOk:  false,
+		},
+		{
+			name:    "value found",
+			o:       newFromPairs(kvp("a", []int{1}), kvp("b", []int{2, 3})),
+			v:       []int{2, 3},
+			wantKey: "b",
+			wantOk:  true,
+		},
+		{
+			name:    "first matching key in order is returned",
+			o:       newFromPairs(kvp("z", []int{1}), kvp("b", []int{2}), kvp("a", []int{2})),
+			v:       []int{2},
+			wantKey: "b",
+			wantOk:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotKey, gotOk := tt.o.FindByValue(tt.v, eq)
+			if gotKey != tt.wantKey {
+				t.Errorf("FindByValue() key = %v, want %v", gotKey, tt.wantKey)
+			}
+			if gotOk != tt.wantOk {
+				t.Errorf("FindByValue() ok = %v, want %v", gotOk, tt.wantOk)
+			}
+			if got := tt.o.ContainsValue(tt.v, eq); got != tt.wantOk {
+				t.Errorf("ContainsValue() = %v, want %v", got, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_ReverseIterator(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, int]
+		want []string
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields nothing",
+			o:    New[string, int](),
+			want: []string{},
+		},
+		{
+			name: "single element map",
+			o:    newFromPairs(kvp("one", 1)),
+			want: []string{"one"},
+		},
+		{
+			name: "visits pairs in reverse map order",
+			o: func() *OrderedMap[string, int] {
+				m := newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3))
+				_ = m.MoveToBack("one")
+				return m
+			}(),
+			want: []string{"one", "three", "two"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			it := tt.o.ReverseIterator()
+			for i := it.Next(); i != nil; i = it.Next() {
+				got = append(got, i.Key)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReverseIterator() = %v, want %v", got, tt.want)
+			}
+			if it.Next() != nil {
+				t.Errorf("ReverseIterator() Next() should remain nil once exhausted")
+			}
+		})
+	}
+}
+
+func TestOrderedMap_GetRef(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	t.Run("missing key", func(t *testing.T) {
+		o := newFromPairs(kvp("a", point{1, 2}))
+		got, ok := o.GetRef("b")
+		if got != nil || ok {
+			t.Errorf("GetRef() = %v, %v, want nil, false", got, ok)
+		}
+	})
+
+	t.Run("writes through the pointer modify the map", func(t *testing.T) {
+		o := newFromPairs(kvp("a", point{1, 2}), kvp("b", point{3, 4}))
+		ref, ok := o.GetRef("b")
+		if !ok {
+			t.Fatalf("GetRef() ok = false, want true")
+		}
+		ref.X = 30
+		compareOrderedMaps(t, newFromPairs(kvp("a", point{1, 2}), kvp("b", point{30, 4})), o)
+	})
+
+	t.Run("writes after removal do not modify the map", func(t *testing.T) {
+		o := newFromPairs(kvp("a", point{1, 2}))
+		ref, _ := o.GetRef("a")
+		o.Remove("a")
+		o.Set("a", point{5, 6})
+		ref.X = 100
+		compareOrderedMaps(t, newFromPairs(kvp("a", point{5, 6})), o)
+	})
+}
+
+func TestOrderedMap_Clear(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, string]
+	}
+	tests := []testCase{
+		{
+			name: "Clear empties a populated map",
+			o:    newFromPairs(kvp("first", "1st"), kvp("second", "2nd"), kvp("third", "3rd")),
+		},
+		{
+			name: "Clear on an empty map",
+			o:    New[string, string](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.o.Clear()
+			compareOrderedMaps(t, New[string, string](), tt.o)
+			if tt.o.Len() != 0 || tt.o.Contains("first") {
+				t.Errorf("Clear() left contents behind: %#v", tt.o)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Compact(t *testing.T) {
+	o := New[int, int]().EnableTimestamps(false)
+	for i := 0; i < 1000; i++ {
+		o.Set(i, i)
+	}
+	for i := 0; i < 1000; i += 2 {
+		o.Remove(i)
+	}
+	ref, _ := o.GetRef(1)
+	inserted, _ := o.InsertedAt(1)
+	want := Collect(o.All())
+
+	o.Compact()
+	compareOrderedMaps(t, want, o)
+	if len(o.items) != o.Len() {
+		t.Errorf("Compact() left %d items for %d pairs", len(o.items), o.Len())
+	}
+	if got, ok := o.InsertedAt(1); !ok || !got.Equal(inserted) {
+		t.Errorf("InsertedAt() = %v, %v after Compact(), want %v, true", got, ok, inserted)
+	}
+
+	*ref = 100
+	if got := o.GetOrDefault(1, 0); got != 100 {
+		t.Errorf("Get() = %v after writing through a GetRef pointer, want 100", got)
+	}
+}
+
+func TestFromMap(t *testing.T) {
+	type testCase struct {
+		name    string
+		m       map[string]int
+		order   []string
+		want    *OrderedMap[string, int]
+		wantErr error
+	}
+	tests := []testCase{
+		{
+			name:  "empty map and order",
+			m:     map[string]int{},
+			order: nil,
+			want:  New[string, int](),
+		},
+		{
+			name:  "inserts keys in the given order",
+			m:     map[string]int{"a": 1, "b": 2, "c": 3},
+			order: []string{"c", "a", "b"},
+			want:  newFromPairs(kvp("c", 3), kvp("a", 1), kvp("b", 2)),
+		},
+		{
+			name:    "errors when order references a missing key",
+			m:       map[string]int{"a": 1},
+			order:   []string{"a", "z"},
+			wantErr: &KeyNotFoundError[string]{Key: "z"},
+		},
+		{
+			name:    "errors when order repeats a key",
+			m:       map[string]int{"a": 1, "b": 2},
+			order:   []string{"a", "b", "a"},
+			wantErr: &DuplicateKeyValueError[string, int]{Key: "a", Value: 1},
+		},
+		{
+			name:    "errors when order omits a key",
+			m:       map[string]int{"a": 1, "b": 2},
+			order:   []string{"b"},
+			wantErr: &UnorderedKeysError[string]{Keys: []string{"a"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromMap(tt.m, tt.order)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Fatalf("FromMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				t.Logf("FromMap() error was: %s", err.Error())
+				if got != nil {
+					t.Errorf("FromMap() = %#v, want nil on error", got)
+				}
+				return
+			}
+			compareOrderedMaps(t, tt.want, got)
+		})
+	}
+}
+
+func TestOf(t *testing.T) {
+	type testCase struct {
+		name  string
+		pairs []KeyValuePair[string, int]
+		want  *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:  "no pairs yields empty map",
+			pairs: nil,
+			want:  New[string, int](),
+		},
+		{
+			name:  "pairs are inserted in order",
+			pairs: []KeyValuePair[string, int]{{Key: "z", Value: 26}, {Key: "a", Value: 1}, {Key: "m", Value: 13}},
+			want:  newFromPairs(kvp("z", 26), kvp("a", 1), kvp("m", 13)),
+		},
+		{
+			name:  "duplicate keys keep first position and last value",
+			pairs: []KeyValuePair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}, {Key: "a", Value: 3}},
+			want:  newFromPairs(kvp("a", 3), kvp("b", 2)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compareOrderedMaps(t, tt.want, Of(tt.pairs...))
+		})
+	}
+}
+
+func TestOrderedMap_Filter(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		pred   func(string, int) bool
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map yields empty map",
+			o:      New[string, int](),
+			pred:   func(string, int) bool { return true },
+			expect: New[string, int](),
+		},
+		{
+			name:   "retains matching pairs in relative order",
+			o:      newFromPairs(kvp("a", 1), kvp("b", -2), kvp("c", 3), kvp("d", 0), kvp("e", 5)),
+			pred:   func(_ string, v int) bool { return v > 0 },
+			expect: newFromPairs(kvp("a", 1), kvp("c", 3), kvp("e", 5)),
+		},
+		{
+			name:   "predicate may inspect keys",
+			o:      newFromPairs(kvp("keep", 1), kvp("drop", 2)),
+			pred:   func(k string, _ int) bool { return k == "keep" },
+			expect: newFromPairs(kvp("keep", 1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := tt.o.Clone()
+			compareOrderedMaps(t, tt.expect, tt.o.Filter(tt.pred))
+			compareOrderedMaps(t, original, tt.o)
+		})
+	}
+}
+
+func TestOrderedMap_Partition(t *testing.T) {
+	type testCase struct {
+		name        string
+		o           *OrderedMap[string, int]
+		pred        func(string, int) bool
+		wantMatched *OrderedMap[string, int]
+		wantRest    *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:        "empty map",
+			o:           New[string, int](),
+			pred:        func(string, int) bool { return true },
+			wantMatched: New[string, int](),
+			wantRest:    New[string, int](),
+		},
+		{
+			name:        "splits pairs retaining order",
+			o:           newFromPairs(kvp("a", -1), kvp("b", 2), kvp("c", -3), kvp("d", 4)),
+			pred:        func(_ string, v int) bool { return v > 0 },
+			wantMatched: newFromPairs(kvp("b", 2), kvp("d", 4)),
+			wantRest:    newFromPairs(kvp("a", -1), kvp("c", -3)),
+		},
+		{
+			name:        "all pairs match",
+			o:           newFromPairs(kvp("a", 1), kvp("b", 2)),
+			pred:        func(string, int) bool { return true },
+			wantMatched: newFromPairs(kvp("a", 1), kvp("b", 2)),
+			wantRest:    New[string, int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := tt.o.Clone()
+			matched, rest := tt.o.Partition(tt.pred)
+			compareOrderedMaps(t, tt.wantMatched, matched)
+			compareOrderedMaps(t, tt.wantRest, rest)
+			compareOrderedMaps(t, original, tt.o)
+		})
+	}
+}
+
+func TestOrderedMap_Slice(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		start  int
+		end    int
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map",
+			o:      New[string, int](),
+			start:  0,
+			end:    2,
+			expect: New[string, int](),
+		},
+		{
+			name:   "inner range",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			start:  1,
+			end:    3,
+			expect: newFromPairs(kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:   "full range",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			start:  0,
+			end:    3,
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:   "out of range bounds are clamped",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			start:  -2,
+			end:    10,
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:   "start beyond length yields empty map",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2)),
+			start:  5,
+			end:    10,
+			expect: New[string, int](),
+		},
+		{
+			name:   "start after end yields empty map",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			start:  2,
+			end:    1,
+			expect: New[string, int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := tt.o.Clone()
+			got := tt.o.Slice(tt.start, tt.end)
+			compareOrderedMaps(t, tt.expect, got)
+
+			got.Set("z", 26)
+			compareOrderedMaps(t, original, tt.o)
+		})
+	}
+}
+
+func TestOrderedMap_RemoveIf(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		pred   func(string, int) bool
+		want   int
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map removes nothing",
+			o:      New[string, int](),
+			pred:   func(string, int) bool { return true },
+			want:   0,
+			expect: New[string, int](),
+		},
+		{
+			name:   "removes matching pairs including first and last",
+			o:      newFromPairs(kvp("a", -1), kvp("b", 2), kvp("c", -3), kvp("d", 4), kvp("e", -5)),
+			pred:   func(_ string, v int) bool { return v < 0 },
+			want:   3,
+			expect: newFromPairs(kvp("b", 2), kvp("d", 4)),
+		},
+		{
+			name:   "removes adjacent pairs",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			pred:   func(k string, _ int) bool { return k != "c" },
+			want:   2,
+			expect: newFromPairs(kvp("c", 3)),
+		},
+		{
+			name:   "removes everything",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2)),
+			pred:   func(string, int) bool { return true },
+			want:   2,
+			expect: New[string, int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.RemoveIf(tt.pred); got != tt.want {
+				t.Errorf("RemoveIf() = %v, want %v", got, tt.want)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+			if tt.o.Len() != tt.expect.Len() {
+				t.Errorf("RemoveIf() Len() = %v, want %v", tt.o.Len(), tt.expect.Len())
+			}
+		})
+	}
+}
+
+func TestOrderedMap_RetainKeys(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		keys   []string
+		want   int
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map removes nothing",
+			o:      New[string, int](),
+			keys:   []string{"a"},
+			want:   0,
+			expect: New[string, int](),
+		},
+		{
+			name:   "retains listed keys in map order",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			keys:   []string{"d", "b", "z"},
+			want:   2,
+			expect: newFromPairs(kvp("b", 2), kvp("d", 4)),
+		},
+		{
+			name:   "no keys removes everything",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2)),
+			keys:   nil,
+			want:   2,
+			expect: New[string, int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.RetainKeys(tt.keys...); got != tt.want {
+				t.Errorf("RetainKeys() = %v, want %v", got, tt.want)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
+func TestOrderedMap_RemoveKeys(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		keys   []string
+		want   int
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map removes nothing",
+			o:      New[string, int](),
+			keys:   []string{"a"},
+			want:   0,
+			expect: New[string, int](),
+		},
+		{
+			name:   "removes listed keys and ignores missing keys",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			keys:   []string{"d", "b", "z", "b"},
+			want:   2,
+			expect: newFromPairs(kvp("a", 1), kvp("c", 3)),
+		},
+		{
+			name:   "no keys removes nothing",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2)),
+			keys:   nil,
+			want:   0,
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.RemoveKeys(tt.keys...); got != tt.want {
+				t.Errorf("RemoveKeys() = %v, want %v", got, tt.want)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
+func TestOrderedMap_At(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		index  int
+		want   *pair[string, int]
+		wantOk bool
+	}
+	five := newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4), kvp("e", 5))
+	tests := []testCase{
+		{name: "empty map", o: New[string, int](), index: 0, want: nil, wantOk: false},
+		{name: "first", o: five, index: 0, want: kvp("a", 1), wantOk: true},
+		{name: "front half", o: five, index: 1, want: kvp("b", 2), wantOk: true},
+		{name: "back half", o: five, index: 3, want: kvp("d", 4), wantOk: true},
+		{name: "last", o: five, index: 4, want: kvp("e", 5), wantOk: true},
+		{name: "negative counts from the back", o: five, index: -1, want: kvp("e", 5), wantOk: true},
+		{name: "negative first", o: five, index: -5, want: kvp("a", 1), wantOk: true},
+		{name: "out of range", o: five, index: 5, want: nil, wantOk: false},
+		{name: "negative out of range", o: five, index: -6, want: nil, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.o.At(tt.index)
+			if !tt.want.Equals(got) {
+				t.Errorf("At() = %v, want %v", got, tt.want)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("At() ok = %v, want %v", ok, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_IndexOf(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, int]
+		key  string
+		want int
+	}
+	tests := []testCase{
+		{
+			name: "missing key in empty map",
+			o:    New[string, int](),
+			key:  "a",
+			want: -1,
+		},
+		{
+			name: "missing key in populated map",
+			o:    newFromPairs(kvp("a", 1), kvp("b", 2)),
+			key:  "z",
+			want: -1,
+		},
+		{
+			name: "first key",
+			o:    newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			key:  "a",
+			want: 0,
+		},
+		{
+			name: "position reflects manipulation",
+			o: func() *OrderedMap[string, int] {
+				m := newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3))
+				_ = m.MoveToBack("a")
+				return m
+			}(),
+			key:  "a",
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.o.IndexOf(tt.key)
+			if got != tt.want {
+				t.Errorf("IndexOf() = %v, want %v", got, tt.want)
+			}
+			if got >= 0 {
+				if at, _ := tt.o.At(got); at.Key != tt.key {
+					t.Errorf("At(IndexOf()) = %v, want key %v", at, tt.key)
+				}
+			}
+		})
+	}
+}
+
+func TestOrderedMap_PopFirst(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		want   *pair[string, int]
+		wantOk bool
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map",
+			o:      New[string, int](),
+			want:   nil,
+			wantOk: false,
+			expect: New[string, int](),
+		},
+		{
+			name:   "single element map",
+			o:      newFromPairs(kvp("a", 1)),
+			want:   kvp("a", 1),
+			wantOk: true,
+			expect: New[string, int](),
+		},
+		{
+			name:   "multiple element map",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			want:   kvp("a", 1),
+			wantOk: true,
+			expect: newFromPairs(kvp("b", 2), kvp("c", 3)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.o.PopFirst()
+			if !tt.want.Equals(got) {
+				t.Errorf("PopFirst() = %v, want %v", got, tt.want)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("PopFirst() ok = %v, want %v", ok, tt.wantOk)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
+func TestOrderedMap_PopLast(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		want   *pair[string, int]
+		wantOk bool
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map",
+			o:      New[string, int](),
+			want:   nil,
+			wantOk: false,
+			expect: New[string, int](),
+		},
+		{
+			name:   "single element map",
+			o:      newFromPairs(kvp("a", 1)),
+			want:   kvp("a", 1),
+			wantOk: true,
+			expect: New[string, int](),
+		},
+		{
+			name:   "multiple element map",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			want:   kvp("c", 3),
+			wantOk: true,
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.o.PopLast()
+			if !tt.want.Equals(got) {
+				t.Errorf("PopLast() = %v, want %v", got, tt.want)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("PopLast() ok = %v, want %v", ok, tt.wantOk)
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+}
+
+func TestOrderedMap_Truncate(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		n      int
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "truncate empty map",
+			o:      New[string, int](),
+			n:      2,
+			expect: New[string, int](),
+		},
+		{
+			name:   "retains first n pairs",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			n:      2,
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2)),
+		},
+		{
+			name:   "n equal to length is a no-op",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2)),
+			n:      2,
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2)),
+		},
+		{
+			name:   "n beyond length is a no-op",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2)),
+			n:      5,
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2)),
+		},
+		{
+			name:   "zero clears the map",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2)),
+			n:      0,
+			expect: New[string, int](),
+		},
+		{
+			name:   "negative clears the map",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2)),
+			n:      -1,
+			expect: New[string, int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.o.Truncate(tt.n)
+			compareOrderedMaps(t, tt.expect, tt.o)
+			if len(tt.o.items) != tt.o.Len() {
+				t.Errorf("Truncate() left %d items for %d ordered pairs", len(tt.o.items), tt.o.Len())
+			}
+		})
+	}
+}
+
+func TestOrderedMap_ToMap(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, int]
+		want map[string]int
+	}
+	tests := []testCase{
+		{
+			name: "nil map yields empty map",
+			o:    nil,
+			want: map[string]int{},
+		},
+		{
+			name: "empty map yields empty map",
+			o:    New[string, int](),
+			want: map[string]int{},
+		},
+		{
+			name: "copies all pairs",
+			o:    newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			want: map[string]int{"a": 1, "b": 2, "c": 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.o.ToMap()
+			if got == nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToMap() = %#v, want %#v", got, tt.want)
+			}
+
+			got["mutated"] = 100
+			if tt.o != nil && tt.o.Contains("mutated") {
+				t.Errorf("ToMap() result shares state with the map")
+			}
+		})
+	}
+}
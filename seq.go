@@ -0,0 +1,146 @@
+package orderedmap
+
+import (
+	"iter"
+
+	"github.com/jimschubert/ordered-map/internal/list"
+)
+
+// RoundRobin yields one pair from each of maps in turn (maps[0]'s first, maps[1]'s first, maps[0]'s second, …),
+// skipping maps which have been exhausted, until all maps are drained.
+//
+// This allows for fair interleaving of several ordered maps. Nil maps are treated as empty.
+func RoundRobin[K comparable, V any](maps ...*OrderedMap[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		cursors := make([]*list.Element[*KeyValuePair[K, V]], 0, len(maps))
+		for _, m := range maps {
+			if m != nil {
+				cursors = append(cursors, m.order.Front())
+			}
+		}
+
+		for remaining := len(cursors); remaining > 0; {
+			remaining = 0
+			for i, e := range cursors {
+				if e == nil {
+					continue
+				}
+				if !yield(e.Value.Key, e.Value.Value) {
+					return
+				}
+				cursors[i] = e.Next()
+				if cursors[i] != nil {
+					remaining++
+				}
+			}
+		}
+	}
+}
+
+// Collect constructs an OrderedMap from the key/value pairs of seq, inserted in the order produced.
+// Duplicate keys follow Set semantics: the last value wins, but the key retains the position of its first appearance.
+//
+// This is the inverse of All, so Collect(o.All()) yields a copy of o.
+func Collect[K comparable, V any](seq iter.Seq2[K, V]) *OrderedMap[K, V] {
+	m := New[K, V]()
+	for k, v := range seq {
+		m.Set(k, v)
+	}
+	return m
+}
+
+// NeighborView holds a pair along with its neighboring pairs in map order.
+// Prev is nil for the first pair, and Next is nil for the last pair.
+type NeighborView[K comparable, V any] struct {
+	Prev    *KeyValuePair[K, V]
+	Current *KeyValuePair[K, V]
+	Next    *KeyValuePair[K, V]
+}
+
+// IterateWithNeighbors yields a NeighborView for each pair in the map, in order.
+func (o *OrderedMap[K, V]) IterateWithNeighbors() iter.Seq[NeighborView[K, V]] {
+	return func(yield func(NeighborView[K, V]) bool) {
+		for e := o.order.Front(); e != nil; e = e.Next() {
+			view := NeighborView[K, V]{Current: e.Value}
+			if prev := e.Prev(); prev != nil {
+				view.Prev = prev.Value
+			}
+			if next := e.Next(); next != nil {
+				view.Next = next.Value
+			}
+			if !yield(view) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the key/value pairs of the map, in order.
+func (o *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := o.order.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.Key, e.Value.Value) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over the keys of the map, in order.
+func (o *OrderedMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for e := o.order.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.Key) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over the values of the map, in order.
+func (o *OrderedMap[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for e := o.order.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Entries returns an iterator over copies of the pairs of the map, in order.
+// Modifying a yielded pair has no effect on the map.
+func (o *OrderedMap[K, V]) Entries() iter.Seq[KeyValuePair[K, V]] {
+	return func(yield func(KeyValuePair[K, V]) bool) {
+		for e := o.order.Front(); e != nil; e = e.Next() {
+			if !yield(KeyValuePair[K, V]{Key: e.Value.Key, Value: e.Value.Value}) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the key/value pairs of the map, in reverse order.
+func (o *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := o.order.Back(); e != nil; e = e.Prev() {
+			if !yield(e.Value.Key, e.Value.Value) {
+				return
+			}
+		}
+	}
+}
+
+// ForEach calls f for each key/value pair of the map, in order, stopping early if f returns false.
+//
+// Modifying the map from within f is undefined behavior.
+func (o *OrderedMap[K, V]) ForEach(f func(K, V) bool) {
+	o.All()(f)
+}
+
+// ForEachReverse calls f for each key/value pair of the map, in reverse order, stopping early if f returns false.
+//
+// Modifying the map from within f is undefined behavior.
+func (o *OrderedMap[K, V]) ForEachReverse(f func(K, V) bool) {
+	o.Backward()(f)
+}
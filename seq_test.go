@@ -0,0 +1,437 @@
+package orderedmap
+
+import (
+	"iter"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestRoundRobin(t *testing.T) {
+	type testCase struct {
+		name  string
+		maps  []*OrderedMap[string, int]
+		limit int
+		want  []string
+	}
+	tests := []testCase{
+		{
+			name: "no maps yields nothing",
+			maps: nil,
+			want: []string{},
+		},
+		{
+			name: "interleaves maps of equal length",
+			maps: []*OrderedMap[string, int]{
+				newFromPairs(kvp("a1", 1), kvp("a2", 2)),
+				newFromPairs(kvp("b1", 1), kvp("b2", 2)),
+			},
+			want: []string{"a1", "b1", "a2", "b2"},
+		},
+		{
+			name: "skips exhausted maps of unequal length",
+			maps: []*OrderedMap[string, int]{
+				newFromPairs(kvp("a1", 1)),
+				newFromPairs(kvp("b1", 1), kvp("b2", 2), kvp("b3", 3)),
+				New[string, int](),
+				nil,
+				newFromPairs(kvp("c1", 1), kvp("c2", 2)),
+			},
+			want: []string{"a1", "b1", "c1", "b2", "c2", "b3"},
+		},
+		{
+			name: "honors early break",
+			maps: []*OrderedMap[string, int]{
+				newFromPairs(kvp("a1", 1), kvp("a2", 2)),
+				newFromPairs(kvp("b1", 1), kvp("b2", 2)),
+			},
+			limit: 3,
+			want:  []string{"a1", "b1", "a2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for k := range RoundRobin(tt.maps...) {
+				got = append(got, k)
+				if tt.limit > 0 && len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RoundRobin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollect(t *testing.T) {
+	type testCase struct {
+		name string
+		seq  iter.Seq2[string, int]
+		want *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name: "empty sequence",
+			seq:  New[string, int]().All(),
+			want: New[string, int](),
+		},
+		{
+			name: "round-trips All",
+			seq:  newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)).All(),
+			want: newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+		},
+		{
+			name: "preserves sequence order",
+			seq:  newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)).Backward(),
+			want: newFromPairs(kvp("three", 3), kvp("two", 2), kvp("one", 1)),
+		},
+		{
+			name: "duplicate keys keep first position and last value",
+			seq: func(yield func(string, int) bool) {
+				_ = yield("one", 1) && yield("two", 2) && yield("one", 10)
+			},
+			want: newFromPairs(kvp("one", 10), kvp("two", 2)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compareOrderedMaps(t, tt.want, Collect(tt.seq))
+		})
+	}
+}
+
+func TestOrderedMap_IterateWithNeighbors(t *testing.T) {
+	key := func(p *KeyValuePair[string, int]) string {
+		if p == nil {
+			return "<nil>"
+		}
+		return p.Key
+	}
+	type testCase struct {
+		name  string
+		o     *OrderedMap[string, int]
+		limit int
+		want  [][3]string
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields nothing",
+			o:    New[string, int](),
+			want: [][3]string{},
+		},
+		{
+			name: "single element map has no neighbors",
+			o:    newFromPairs(kvp("only", 1)),
+			want: [][3]string{{"<nil>", "only", "<nil>"}},
+		},
+		{
+			name: "neighbors are linked in order",
+			o:    newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			want: [][3]string{
+				{"<nil>", "a", "b"},
+				{"a", "b", "c"},
+				{"b", "c", "<nil>"},
+			},
+		},
+		{
+			name:  "honors early break",
+			o:     newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			limit: 1,
+			want:  [][3]string{{"<nil>", "a", "b"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([][3]string, 0)
+			for view := range tt.o.IterateWithNeighbors() {
+				got = append(got, [3]string{key(view.Prev), key(view.Current), key(view.Next)})
+				if tt.limit > 0 && len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IterateWithNeighbors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_All(t *testing.T) {
+	type testCase struct {
+		name  string
+		o     *OrderedMap[string, int]
+		limit int
+		want  []KeyValuePair[string, int]
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields nothing",
+			o:    New[string, int](),
+			want: []KeyValuePair[string, int]{},
+		},
+		{
+			name: "yields pairs in map order",
+			o: func() *OrderedMap[string, int] {
+				m := newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3))
+				_ = m.MoveToFront("three")
+				return m
+			}(),
+			want: []KeyValuePair[string, int]{{Key: "three", Value: 3}, {Key: "one", Value: 1}, {Key: "two", Value: 2}},
+		},
+		{
+			name:  "honors early break",
+			o:     newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			limit: 2,
+			want:  []KeyValuePair[string, int]{{Key: "one", Value: 1}, {Key: "two", Value: 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]KeyValuePair[string, int], 0)
+			for k, v := range tt.o.All() {
+				got = append(got, KeyValuePair[string, int]{Key: k, Value: v})
+				if tt.limit > 0 && len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("All() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_KeysSeq(t *testing.T) {
+	type testCase struct {
+		name  string
+		o     *OrderedMap[string, int]
+		limit int
+		want  []string
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields nothing",
+			o:    New[string, int](),
+			want: []string{},
+		},
+		{
+			name: "yields keys in map order",
+			o:    newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			want: []string{"one", "two", "three"},
+		},
+		{
+			name:  "honors early break",
+			o:     newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			limit: 1,
+			want:  []string{"one"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for k := range tt.o.KeysSeq() {
+				got = append(got, k)
+				if tt.limit > 0 && len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KeysSeq() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("composes with slices.Sorted", func(t *testing.T) {
+		o := newFromPairs(kvp("c", 1), kvp("a", 2), kvp("b", 3))
+		if got := slices.Sorted(o.KeysSeq()); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+			t.Errorf("slices.Sorted(KeysSeq()) = %v", got)
+		}
+	})
+}
+
+func TestOrderedMap_ValuesSeq(t *testing.T) {
+	type testCase struct {
+		name  string
+		o     *OrderedMap[string, int]
+		limit int
+		want  []int
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields nothing",
+			o:    New[string, int](),
+			want: []int{},
+		},
+		{
+			name: "yields values in map order",
+			o:    newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			want: []int{1, 2, 3},
+		},
+		{
+			name:  "honors early break",
+			o:     newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			limit: 2,
+			want:  []int{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]int, 0)
+			for v := range tt.o.ValuesSeq() {
+				got = append(got, v)
+				if tt.limit > 0 && len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValuesSeq() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Entries(t *testing.T) {
+	type testCase struct {
+		name  string
+		o     *OrderedMap[string, int]
+		limit int
+		want  []KeyValuePair[string, int]
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields nothing",
+			o:    New[string, int](),
+			want: []KeyValuePair[string, int]{},
+		},
+		{
+			name: "yields pairs in map order",
+			o:    newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			want: []KeyValuePair[string, int]{{Key: "one", Value: 1}, {Key: "two", Value: 2}, {Key: "three", Value: 3}},
+		},
+		{
+			name:  "honors early break",
+			o:     newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			limit: 1,
+			want:  []KeyValuePair[string, int]{{Key: "one", Value: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]KeyValuePair[string, int], 0)
+			for p := range tt.o.Entries() {
+				got = append(got, p)
+				if tt.limit > 0 && len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Entries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("yields copies", func(t *testing.T) {
+		o := newFromPairs(kvp("one", 1))
+		entries := slices.Collect(o.Entries())
+		entries[0].Value = 10
+		if got := o.GetOrDefault("one", 0); got != 1 {
+			t.Errorf("GetOrDefault() = %v after modifying a copy, want 1", got)
+		}
+	})
+}
+
+func TestOrderedMap_Backward(t *testing.T) {
+	type testCase struct {
+		name  string
+		o     *OrderedMap[string, int]
+		limit int
+		want  []KeyValuePair[string, int]
+	}
+	tests := []testCase{
+		{
+			name: "empty map yields nothing",
+			o:    New[string, int](),
+			want: []KeyValuePair[string, int]{},
+		},
+		{
+			name: "yields pairs in reverse map order",
+			o:    newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			want: []KeyValuePair[string, int]{{Key: "three", Value: 3}, {Key: "two", Value: 2}, {Key: "one", Value: 1}},
+		},
+		{
+			name:  "honors early break",
+			o:     newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			limit: 1,
+			want:  []KeyValuePair[string, int]{{Key: "three", Value: 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]KeyValuePair[string, int], 0)
+			for k, v := range tt.o.Backward() {
+				got = append(got, KeyValuePair[string, int]{Key: k, Value: v})
+				if tt.limit > 0 && len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Backward() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_ForEach(t *testing.T) {
+	type testCase struct {
+		name        string
+		o           *OrderedMap[string, int]
+		limit       int
+		want        []KeyValuePair[string, int]
+		wantReverse []KeyValuePair[string, int]
+	}
+	tests := []testCase{
+		{
+			name:        "empty map calls nothing",
+			o:           New[string, int](),
+			want:        []KeyValuePair[string, int]{},
+			wantReverse: []KeyValuePair[string, int]{},
+		},
+		{
+			name:        "calls for each pair",
+			o:           newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			want:        []KeyValuePair[string, int]{{Key: "one", Value: 1}, {Key: "two", Value: 2}, {Key: "three", Value: 3}},
+			wantReverse: []KeyValuePair[string, int]{{Key: "three", Value: 3}, {Key: "two", Value: 2}, {Key: "one", Value: 1}},
+		},
+		{
+			name:        "stops when f returns false",
+			o:           newFromPairs(kvp("one", 1), kvp("two", 2), kvp("three", 3)),
+			limit:       2,
+			want:        []KeyValuePair[string, int]{{Key: "one", Value: 1}, {Key: "two", Value: 2}},
+			wantReverse: []KeyValuePair[string, int]{{Key: "three", Value: 3}, {Key: "two", Value: 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collect := func(got *[]KeyValuePair[string, int]) func(string, int) bool {
+				return func(k string, v int) bool {
+					*got = append(*got, KeyValuePair[string, int]{Key: k, Value: v})
+					return tt.limit == 0 || len(*got) < tt.limit
+				}
+			}
+
+			got := make([]KeyValuePair[string, int], 0)
+			tt.o.ForEach(collect(&got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForEach() = %v, want %v", got, tt.want)
+			}
+
+			got = make([]KeyValuePair[string, int], 0)
+			tt.o.ForEachReverse(collect(&got))
+			if !reflect.DeepEqual(got, tt.wantReverse) {
+				t.Errorf("ForEachReverse() = %v, want %v", got, tt.wantReverse)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package orderedmap
+
+import (
+	"slices"
+
+	"github.com/jimschubert/ordered-map/internal/list"
+)
+
+// SortKeys reorders the map such that keys are sorted according to less.
+// The sort is stable: keys which are neither less than the other retain their relative order.
+//
+// This reorders the existing pairs in place, so no pairs are reallocated.
+func (o *OrderedMap[K, V]) SortKeys(less func(a, b K) bool) {
+	o.sortPairs(func(a, b *KeyValuePair[K, V]) bool {
+		return less(a.Key, b.Key)
+	})
+}
+
+// SortByValue reorders the map such that pairs are sorted by value according to less.
+// The sort is stable: pairs with values which are neither less than the other retain their relative order.
+//
+// This reorders the existing pairs in place, so pointers obtained via GetRef remain valid and the map may continue
+// to be manipulated as usual.
+func (o *OrderedMap[K, V]) SortByValue(less func(a, b V) bool) {
+	o.sortPairs(func(a, b *KeyValuePair[K, V]) bool {
+		return less(a.Value, b.Value)
+	})
+}
+
+// sortPairs stably reorders the underlying list according to less, retaining each list element.
+// Each pair whose position changes is notified as a ChangeMove, in the new order.
+func (o *OrderedMap[K, V]) sortPairs(less func(a, b *KeyValuePair[K, V]) bool) {
+	defer o.holdEvents()()
+	elements := make([]*list.Element[*KeyValuePair[K, V]], 0, o.order.Len())
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		elements = append(elements, e)
+	}
+	original := slices.Clone(elements)
+
+	slices.SortStableFunc(elements, func(a, b *list.Element[*KeyValuePair[K, V]]) int {
+		switch {
+		case less(a.Value, b.Value):
+			return -1
+		case less(b.Value, a.Value):
+			return 1
+		}
+		return 0
+	})
+
+	for _, e := range elements {
+		o.order.MoveToBack(e)
+	}
+	for i, e := range elements {
+		if original[i] != e {
+			o.notify(ChangeMove, e.Value.Key, e.Value.Value, e.Value.Value)
+		}
+	}
+}
+
+// Reverse reverses the order of the map in place, such that First and Last are exchanged.
+// Each pair whose position changes is notified as a ChangeMove, in the new order.
+func (o *OrderedMap[K, V]) Reverse() *OrderedMap[K, V] {
+	size := o.order.Len()
+	if size < 2 {
+		return o
+	}
+	defer o.holdEvents()()
+
+	for e := o.order.Front().Next(); e != nil; {
+		next := e.Next()
+		o.order.MoveToFront(e)
+		e = next
+	}
+
+	i := 0
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		// the middle pair of an odd length map keeps its position
+		if size%2 == 0 || i != size/2 {
+			o.notify(ChangeMove, e.Value.Key, e.Value.Value, e.Value.Value)
+		}
+		i++
+	}
+	return o
+}
+
+// Rotate rotates the order of the map left by n positions in place, wrapping around, such that Rotate(1) moves the
+// front pair to the back. A negative n rotates right. Rotation is taken modulo Len.
+// Unless the rotation is a no-op, every pair changes position and is notified as a ChangeMove, in the new order.
+func (o *OrderedMap[K, V]) Rotate(n int) {
+	size := o.order.Len()
+	if size < 2 {
+		return
+	}
+
+	n = (n%size + size) % size
+	if n == 0 {
+		return
+	}
+	defer o.holdEvents()()
+
+	if n <= size/2 {
+		for i := 0; i < n; i++ {
+			o.order.MoveToBack(o.order.Front())
+		}
+	} else {
+		for i := 0; i < size-n; i++ {
+			o.order.MoveToFront(o.order.Back())
+		}
+	}
+
+	for e := o.order.Front(); e != nil; e = e.Next() {
+		o.notify(ChangeMove, e.Value.Key, e.Value.Value, e.Value.Value)
+	}
+}
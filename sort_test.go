@@ -0,0 +1,238 @@
+package orderedmap
+
+import (
+	"reflect"
+	"testing"
+)
+
+// movedKeys returns the keys of each ChangeMove observed while calling mutate.
+func movedKeys[K comparable, V any](o *OrderedMap[K, V], mutate func()) []K {
+	moved := make([]K, 0)
+	unregister := o.OnChange(func(event ChangeEvent[K, V]) {
+		if event.Op == ChangeMove {
+			moved = append(moved, event.Key)
+		}
+	})
+	defer unregister()
+	mutate()
+	return moved
+}
+
+func TestOrderedMap_SortKeys(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		less   func(a, b string) bool
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map",
+			o:      New[string, int](),
+			less:   func(a, b string) bool { return a < b },
+			expect: New[string, int](),
+		},
+		{
+			name:   "sorts keys ascending",
+			o:      newFromPairs(kvp("mango", 1), kvp("apple", 2), kvp("zebra", 3), kvp("kiwi", 4)),
+			less:   func(a, b string) bool { return a < b },
+			expect: newFromPairs(kvp("apple", 2), kvp("kiwi", 4), kvp("mango", 1), kvp("zebra", 3)),
+		},
+		{
+			name:   "sort is stable for equivalent keys",
+			o:      newFromPairs(kvp("bb", 1), kvp("a", 2), kvp("cc", 3), kvp("d", 4)),
+			less:   func(a, b string) bool { return len(a) < len(b) },
+			expect: newFromPairs(kvp("a", 2), kvp("d", 4), kvp("bb", 1), kvp("cc", 3)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.o.SortKeys(tt.less)
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+
+	t.Run("map remains usable after sorting", func(t *testing.T) {
+		o := newFromPairs(kvp("c", 3), kvp("a", 1), kvp("b", 2))
+		o.SortKeys(func(a, b string) bool { return a < b })
+		if err := o.MoveToFront("c"); err != nil {
+			t.Fatalf("MoveToFront() error = %v", err)
+		}
+		o.Remove("a")
+		if got := o.Keys(); !reflect.DeepEqual(got, []string{"c", "b"}) {
+			t.Errorf("Keys() = %v, want [c b]", got)
+		}
+	})
+
+	t.Run("notifies each pair which moves", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1), kvp("d", 4), kvp("c", 3), kvp("b", 2), kvp("e", 5))
+		got := movedKeys(o, func() { o.SortKeys(func(a, b string) bool { return a < b }) })
+		if want := []string{"b", "d"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("SortKeys() moved %v, want %v", got, want)
+		}
+
+		events, unsubscribe := o.Subscribe()
+		defer unsubscribe()
+		o.SortByValue(func(a, b int) bool { return a > b })
+		if got := len(events); got != 4 {
+			t.Errorf("SortByValue() sent %d events, want 4", got)
+		}
+	})
+}
+
+func TestOrderedMap_SortByValue(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		less   func(a, b int) bool
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map",
+			o:      New[string, int](),
+			less:   func(a, b int) bool { return a > b },
+			expect: New[string, int](),
+		},
+		{
+			name:   "leaderboard sorted descending with stable ties",
+			o:      newFromPairs(kvp("ann", 30), kvp("bob", 50), kvp("cat", 30), kvp("dan", 70)),
+			less:   func(a, b int) bool { return a > b },
+			expect: newFromPairs(kvp("dan", 70), kvp("bob", 50), kvp("ann", 30), kvp("cat", 30)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.o.SortByValue(tt.less)
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+
+	t.Run("references remain valid after sorting", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 3), kvp("b", 1), kvp("c", 2))
+		ref, _ := o.GetRef("a")
+		o.SortByValue(func(a, b int) bool { return a < b })
+		*ref = 0
+		if err := o.MoveAfter("b", "c"); err != nil {
+			t.Fatalf("MoveAfter() error = %v", err)
+		}
+		compareOrderedMaps(t, newFromPairs(kvp("c", 2), kvp("b", 1), kvp("a", 0)), o)
+	})
+}
+
+func TestOrderedMap_Reverse(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map",
+			o:      New[string, int](),
+			expect: New[string, int](),
+		},
+		{
+			name:   "single element map",
+			o:      newFromPairs(kvp("a", 1)),
+			expect: newFromPairs(kvp("a", 1)),
+		},
+		{
+			name:   "multiple element map",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			expect: newFromPairs(kvp("d", 4), kvp("c", 3), kvp("b", 2), kvp("a", 1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Reverse(); got != tt.o {
+				t.Errorf("Reverse() did not return the receiver")
+			}
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+
+	t.Run("notifies each pair which moves", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3))
+		got := movedKeys(o, func() { o.Reverse() })
+		if want := []string{"c", "a"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Reverse() moved %v, want %v", got, want)
+		}
+
+		got = movedKeys(o, func() { o.Reverse().Reverse() })
+		if want := []string{"a", "c", "c", "a"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Reverse().Reverse() moved %v, want %v", got, want)
+		}
+	})
+}
+
+func TestOrderedMap_Rotate(t *testing.T) {
+	type testCase struct {
+		name   string
+		o      *OrderedMap[string, int]
+		n      int
+		expect *OrderedMap[string, int]
+	}
+	tests := []testCase{
+		{
+			name:   "empty map",
+			o:      New[string, int](),
+			n:      1,
+			expect: New[string, int](),
+		},
+		{
+			name:   "rotate left by one",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			n:      1,
+			expect: newFromPairs(kvp("b", 2), kvp("c", 3), kvp("d", 4), kvp("a", 1)),
+		},
+		{
+			name:   "rotate left by more than half",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			n:      3,
+			expect: newFromPairs(kvp("d", 4), kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:   "rotate right by one",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3), kvp("d", 4)),
+			n:      -1,
+			expect: newFromPairs(kvp("d", 4), kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:   "rotate by length is a no-op",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			n:      3,
+			expect: newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+		},
+		{
+			name:   "rotate by more than length wraps",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			n:      7,
+			expect: newFromPairs(kvp("b", 2), kvp("c", 3), kvp("a", 1)),
+		},
+		{
+			name:   "rotate right by more than length wraps",
+			o:      newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3)),
+			n:      -7,
+			expect: newFromPairs(kvp("c", 3), kvp("a", 1), kvp("b", 2)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.o.Rotate(tt.n)
+			compareOrderedMaps(t, tt.expect, tt.o)
+		})
+	}
+
+	t.Run("notifies each pair which moves", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1), kvp("b", 2), kvp("c", 3))
+		got := movedKeys(o, func() { o.Rotate(-1) })
+		if want := []string{"c", "a", "b"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Rotate() moved %v, want %v", got, want)
+		}
+
+		if got := movedKeys(o, func() { o.Rotate(3) }); len(got) != 0 {
+			t.Errorf("Rotate() by length moved %v, want none", got)
+		}
+	})
+}
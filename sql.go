@@ -0,0 +1,35 @@
+package orderedmap
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value fulfills the driver.Valuer interface, storing the map as JSON bytes in map order, such as for a JSON or JSONB
+// column. A nil map is stored as SQL NULL.
+func (o *OrderedMap[K, V]) Value() (driver.Value, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return o.MarshalJSON()
+}
+
+// Scan fulfills the sql.Scanner interface, reading a JSON object from a []byte or string source in source order.
+// Any existing contents of o are cleared, and SQL NULL leaves o empty. An unsupported source is an error which leaves
+// o untouched.
+func (o *OrderedMap[K, V]) Scan(src any) error {
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+		o.Init()
+		return nil
+	case []byte:
+		data = src
+	case string:
+		data = []byte(src)
+	default:
+		return fmt.Errorf("orderedmap: cannot scan %T into OrderedMap, expected []byte or string", src)
+	}
+	o.Init()
+	return o.UnmarshalJSON(data)
+}
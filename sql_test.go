@@ -0,0 +1,113 @@
+package orderedmap
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+)
+
+var (
+	_ driver.Valuer = (*OrderedMap[string, int])(nil)
+	_ sql.Scanner   = (*OrderedMap[string, int])(nil)
+)
+
+func TestOrderedMap_Value(t *testing.T) {
+	type testCase struct {
+		name string
+		o    *OrderedMap[string, int]
+		want driver.Value
+	}
+	tests := []testCase{
+		{
+			name: "nil map is NULL",
+			o:    nil,
+			want: nil,
+		},
+		{
+			name: "empty map is an empty object",
+			o:    New[string, int](),
+			want: []byte(`{}`),
+		},
+		{
+			name: "keys are stored in map order",
+			o:    newFromPairs(kvp("zebra", 1), kvp("apple", 2)),
+			want: []byte(`{"zebra":1,"apple":2}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Scan(t *testing.T) {
+	type testCase struct {
+		name    string
+		src     any
+		want    *OrderedMap[string, int]
+		wantErr bool
+	}
+	tests := []testCase{
+		{
+			name: "NULL clears the map",
+			src:  nil,
+			want: New[string, int](),
+		},
+		{
+			name: "bytes are read in source order",
+			src:  []byte(`{"zebra":1,"apple":2}`),
+			want: newFromPairs(kvp("zebra", 1), kvp("apple", 2)),
+		},
+		{
+			name: "string is read in source order",
+			src:  `{"mango":3,"kiwi":4}`,
+			want: newFromPairs(kvp("mango", 3), kvp("kiwi", 4)),
+		},
+		{
+			name:    "unsupported source is an error",
+			src:     42,
+			wantErr: true,
+		},
+		{
+			name:    "malformed JSON is an error",
+			src:     `[1, 2]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newFromPairs(kvp("stale", 0))
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				compareOrderedMaps(t, tt.want, got)
+			}
+		})
+	}
+
+	t.Run("unsupported source leaves the map intact", func(t *testing.T) {
+		got := newFromPairs(kvp("kept", 1))
+		if err := got.Scan(int64(42)); err == nil {
+			t.Fatalf("Scan() error = nil, want an error")
+		}
+		compareOrderedMaps(t, newFromPairs(kvp("kept", 1)), got)
+	})
+
+	t.Run("zero value map", func(t *testing.T) {
+		var got OrderedMap[string, int]
+		if err := got.Scan([]byte(`{"b":2,"a":1}`)); err != nil {
+			t.Fatalf("Scan() error = %v", err)
+		}
+		compareOrderedMaps(t, newFromPairs(kvp("b", 2), kvp("a", 1)), &got)
+	})
+}
@@ -0,0 +1,208 @@
+package orderedmap
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// subscriptionBufferSize is the capacity of each channel returned by Subscribe.
+const subscriptionBufferSize = 64
+
+// ChangeOp identifies the kind of mutation conveyed by a ChangeEvent.
+type ChangeOp int
+
+const (
+	// ChangeInsert signals that a new key was added to the map.
+	ChangeInsert ChangeOp = iota
+	// ChangeUpdate signals that the value of an existing key was modified.
+	ChangeUpdate
+	// ChangeRemove signals that a key was removed from the map.
+	ChangeRemove
+	// ChangeMove signals that a key was repositioned within the map.
+	ChangeMove
+	// ChangeClear signals that all keys were removed from the map. Key and values are zero.
+	ChangeClear
+)
+
+// String provides a string representation of this ChangeOp.
+func (c ChangeOp) String() string {
+	switch c {
+	case ChangeInsert:
+		return "insert"
+	case ChangeUpdate:
+		return "update"
+	case ChangeRemove:
+		return "remove"
+	case ChangeMove:
+		return "move"
+	case ChangeClear:
+		return "clear"
+	}
+	return "unknown"
+}
+
+// ChangeEvent conveys a single mutation of an OrderedMap to subscribers.
+//
+// OldValue is the zero value for ChangeInsert, and NewValue is the zero value for ChangeRemove.
+type ChangeEvent[K comparable, V any] struct {
+	Op       ChangeOp
+	Key      K
+	OldValue V
+	NewValue V
+}
+
+type subscribers[K comparable, V any] struct {
+	mu       sync.Mutex
+	channels []chan ChangeEvent[K, V]
+	hooks    []*func(ChangeEvent[K, V])
+	dropped  atomic.Uint64
+	// pending holds events awaiting dispatch to hooks, which is held back while depth is non-zero
+	pending []ChangeEvent[K, V]
+	depth   int
+}
+
+func (o *OrderedMap[K, V]) ensureSubscribers() *subscribers[K, V] {
+	if o.subscribers == nil {
+		o.subscribers = &subscribers[K, V]{}
+	}
+	return o.subscribers
+}
+
+// Subscribe returns a channel which receives a ChangeEvent for each mutation of the map, and a function to
+// unsubscribe. Unsubscribing closes the channel and is safe to call more than once.
+//
+// Each subscriber receives every event. Delivery never blocks the mutating caller: if a subscriber's channel is full,
+// the event is dropped for that subscriber and counted in DroppedEvents.
+func (o *OrderedMap[K, V]) Subscribe() (<-chan ChangeEvent[K, V], func()) {
+	s := o.ensureSubscribers()
+	ch := make(chan ChangeEvent[K, V], subscriptionBufferSize)
+
+	s.mu.Lock()
+	s.channels = append(s.channels, ch)
+	s.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			for i, c := range s.channels {
+				if c == ch {
+					s.channels = append(s.channels[:i], s.channels[i+1:]...)
+					break
+				}
+			}
+			close(ch)
+		})
+	}
+}
+
+// OnChange registers fn to be called synchronously with a ChangeEvent after each mutation of the map completes, and
+// returns a function to unregister it. Unregistering is safe to call more than once.
+//
+// Observers are called in the order they were registered, on the goroutine performing the mutation. Unlike Subscribe,
+// no events are dropped, but a slow observer delays the mutating caller.
+//
+// Observers may read or mutate the map. Bulk operations such as RemoveIf or SortKeys complete before any of their
+// events reach observers, and events caused by an observer are delivered after those already pending, so observers
+// never interrupt an operation in progress.
+func (o *OrderedMap[K, V]) OnChange(fn func(event ChangeEvent[K, V])) func() {
+	s := o.ensureSubscribers()
+	hook := &fn
+
+	s.mu.Lock()
+	s.hooks = append(s.hooks, hook)
+	s.mu.Unlock()
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for i, h := range s.hooks {
+			if h == hook {
+				s.hooks = append(s.hooks[:i:i], s.hooks[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// DroppedEvents returns the number of events which could not be delivered to subscribers because their channels were full.
+func (o *OrderedMap[K, V]) DroppedEvents() uint64 {
+	if o.subscribers == nil {
+		return 0
+	}
+	return o.subscribers.dropped.Load()
+}
+
+func (o *OrderedMap[K, V]) notify(op ChangeOp, key K, oldValue, newValue V) {
+	if o.subscribers == nil {
+		return
+	}
+	s := o.subscribers
+	event := ChangeEvent[K, V]{Op: op, Key: key, OldValue: oldValue, NewValue: newValue}
+
+	s.mu.Lock()
+	for _, ch := range s.channels {
+		select {
+		case ch <- event:
+		default:
+			s.dropped.Add(1)
+		}
+	}
+	if len(s.hooks) > 0 {
+		s.pending = append(s.pending, event)
+	}
+	s.mu.Unlock()
+
+	o.dispatch()
+}
+
+// holdEvents defers calling observers until the returned function is called, so that a bulk operation completes
+// before observers, which may mutate the map, are called.
+func (o *OrderedMap[K, V]) holdEvents() func() {
+	if o.subscribers == nil {
+		return func() {}
+	}
+	s := o.subscribers
+	s.mu.Lock()
+	s.depth++
+	s.mu.Unlock()
+
+	return func() {
+		s.mu.Lock()
+		s.depth--
+		s.mu.Unlock()
+		o.dispatch()
+	}
+}
+
+// dispatch calls observers with each pending event in order, unless events are held or already being dispatched.
+func (o *OrderedMap[K, V]) dispatch() {
+	s := o.subscribers
+	s.mu.Lock()
+	if s.depth > 0 {
+		s.mu.Unlock()
+		return
+	}
+	// holding events while dispatching queues any events caused by observers behind those already pending
+	s.depth++
+	defer func() {
+		s.mu.Lock()
+		s.depth--
+		s.mu.Unlock()
+	}()
+
+	for len(s.pending) > 0 {
+		event := s.pending[0]
+		s.pending = s.pending[1:]
+		// observers are called without holding the lock, so that they may mutate the map or unregister themselves
+		hooks := s.hooks
+		s.mu.Unlock()
+
+		for _, hook := range hooks {
+			(*hook)(event)
+		}
+		s.mu.Lock()
+	}
+	s.mu.Unlock()
+}
@@ -0,0 +1,207 @@
+package orderedmap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func drain[K comparable, V any](ch <-chan ChangeEvent[K, V]) []ChangeEvent[K, V] {
+	events := make([]ChangeEvent[K, V], 0)
+	for {
+		select {
+		case event, ok := <-ch:
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestOrderedMap_Subscribe(t *testing.T) {
+	t.Run("subscriber receives Set and Remove events", func(t *testing.T) {
+		o := newFromPairs(kvp("existing", 1))
+		events, unsubscribe := o.Subscribe()
+		defer unsubscribe()
+
+		o.Set("new", 2)
+		o.Set("existing", 10)
+		o.Remove("new")
+		o.Remove("missing")
+
+		want := []ChangeEvent[string, int]{
+			{Op: ChangeInsert, Key: "new", NewValue: 2},
+			{Op: ChangeUpdate, Key: "existing", OldValue: 1, NewValue: 10},
+			{Op: ChangeRemove, Key: "new", OldValue: 2},
+		}
+		if got := drain(events); !reflect.DeepEqual(got, want) {
+			t.Errorf("Subscribe() events = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("multiple subscribers each receive events", func(t *testing.T) {
+		o := New[string, int]()
+		first, unsubscribeFirst := o.Subscribe()
+		second, unsubscribeSecond := o.Subscribe()
+		defer unsubscribeSecond()
+
+		o.Set("a", 1)
+		unsubscribeFirst()
+		unsubscribeFirst()
+		_ = o.MoveToFront("a")
+
+		want := []ChangeEvent[string, int]{{Op: ChangeInsert, Key: "a", NewValue: 1}}
+		if got := drain(first); !reflect.DeepEqual(got, want) {
+			t.Errorf("first subscriber events = %+v, want %+v", got, want)
+		}
+		want = append(want, ChangeEvent[string, int]{Op: ChangeMove, Key: "a", OldValue: 1, NewValue: 1})
+		if got := drain(second); !reflect.DeepEqual(got, want) {
+			t.Errorf("second subscriber events = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("full channels drop events without blocking", func(t *testing.T) {
+		o := New[int, int]()
+		events, unsubscribe := o.Subscribe()
+		defer unsubscribe()
+
+		total := subscriptionBufferSize + 5
+		for i := 0; i < total; i++ {
+			o.Set(i, i)
+		}
+
+		if got := len(drain(events)); got != subscriptionBufferSize {
+			t.Errorf("received %d events, want %d", got, subscriptionBufferSize)
+		}
+		if got := o.DroppedEvents(); got != 5 {
+			t.Errorf("DroppedEvents() = %d, want 5", got)
+		}
+	})
+}
+
+func TestOrderedMap_OnChange(t *testing.T) {
+	t.Run("observers see Set, Remove, and Move after the mutation completes", func(t *testing.T) {
+		o := newFromPairs(kvp("existing", 1))
+		var got []ChangeEvent[string, int]
+		unregister := o.OnChange(func(event ChangeEvent[string, int]) {
+			if event.Op != ChangeRemove {
+				if value, ok := o.Get(event.Key); !ok || *value != event.NewValue {
+					t.Errorf("OnChange() observed %+v before the mutation completed", event)
+				}
+			} else if o.Contains(event.Key) {
+				t.Errorf("OnChange() observed %+v before the removal completed", event)
+			}
+			got = append(got, event)
+		})
+		defer unregister()
+
+		o.Set("new", 2)
+		o.Set("existing", 10)
+		_ = o.MoveToFront("new")
+		o.Remove("new")
+		o.Remove("missing")
+
+		want := []ChangeEvent[string, int]{
+			{Op: ChangeInsert, Key: "new", NewValue: 2},
+			{Op: ChangeUpdate, Key: "existing", OldValue: 1, NewValue: 10},
+			{Op: ChangeMove, Key: "new", OldValue: 2, NewValue: 2},
+			{Op: ChangeRemove, Key: "new", OldValue: 2},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("OnChange() events = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("observers see positional inserts after the pair is positioned", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1), kvp("b", 2))
+		var got [][]string
+		o.OnChange(func(ChangeEvent[string, int]) {
+			got = append(got, o.Keys())
+		})
+
+		_ = o.InsertAt(0, "z", 0)
+		_ = o.InsertAfter("y", 0, "z")
+		_ = o.InsertBefore("x", 0, "b")
+
+		want := [][]string{{"z", "a", "b"}, {"z", "y", "a", "b"}, {"z", "y", "a", "x", "b"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("OnChange() observed keys %v, want %v", got, want)
+		}
+	})
+
+	t.Run("multiple observers are called in order and may unregister", func(t *testing.T) {
+		o := New[string, int]()
+		var calls []string
+		unregisterFirst := o.OnChange(func(ChangeEvent[string, int]) { calls = append(calls, "first") })
+		unregisterSecond := o.OnChange(func(ChangeEvent[string, int]) { calls = append(calls, "second") })
+		defer unregisterSecond()
+
+		o.Set("a", 1)
+		unregisterFirst()
+		unregisterFirst()
+		o.Set("b", 2)
+
+		if want := []string{"first", "second", "second"}; !reflect.DeepEqual(calls, want) {
+			t.Errorf("OnChange() calls = %v, want %v", calls, want)
+		}
+	})
+
+	t.Run("observers may mutate the map", func(t *testing.T) {
+		o := New[string, int]()
+		o.OnChange(func(event ChangeEvent[string, int]) {
+			if event.Op == ChangeInsert && event.Key != "audit" {
+				o.Set("audit", event.NewValue)
+			}
+		})
+
+		o.Set("a", 1)
+		compareOrderedMaps(t, newFromPairs(kvp("a", 1), kvp("audit", 1)), o)
+	})
+
+	t.Run("bulk operations complete before observers are called", func(t *testing.T) {
+		o := newFromPairs(kvp(1, 1), kvp(2, 2), kvp(3, 3), kvp(4, 4))
+		var got []ChangeEvent[int, int]
+		o.OnChange(func(event ChangeEvent[int, int]) {
+			got = append(got, event)
+			if event.Op == ChangeRemove {
+				o.Remove(event.Key + 1)
+			}
+		})
+
+		if removed := o.RemoveIf(func(int, int) bool { return true }); removed != 4 {
+			t.Errorf("RemoveIf() = %d, want 4", removed)
+		}
+		compareOrderedMaps(t, New[int, int](), o)
+
+		want := []ChangeEvent[int, int]{
+			{Op: ChangeRemove, Key: 1, OldValue: 1},
+			{Op: ChangeRemove, Key: 2, OldValue: 2},
+			{Op: ChangeRemove, Key: 3, OldValue: 3},
+			{Op: ChangeRemove, Key: 4, OldValue: 4},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("OnChange() events = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("events caused by observers follow those already pending", func(t *testing.T) {
+		o := New[string, int]()
+		var got []string
+		o.OnChange(func(event ChangeEvent[string, int]) {
+			got = append(got, event.Op.String()+" "+event.Key)
+			if event.Key != "audit" {
+				o.Set("audit", event.NewValue)
+			}
+		})
+
+		o.SetMany(KeyValuePair[string, int]{Key: "a", Value: 1}, KeyValuePair[string, int]{Key: "b", Value: 2})
+
+		want := []string{"insert a", "insert b", "insert audit", "update audit"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("OnChange() events = %v, want %v", got, want)
+		}
+		compareOrderedMaps(t, newFromPairs(kvp("a", 1), kvp("b", 2), kvp("audit", 2)), o)
+	})
+}
@@ -0,0 +1,66 @@
+package orderedmap
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// TextMap wraps an OrderedMap of strings to fulfill encoding.TextMarshaler and encoding.TextUnmarshaler, using a
+// newline-delimited key=value format such as that of dotenv files.
+//
+// This is a separate type because Go does not allow methods to constrain the type parameters of OrderedMap.
+type TextMap struct {
+	*OrderedMap[string, string]
+}
+
+// MarshalText writes each pair as a key=value line, in map order.
+// Returns an error if a key is empty, begins with '#', has leading or trailing whitespace, or contains '=' or a line
+// break, or if a value contains a line break. Such keys would not survive UnmarshalText unchanged.
+func (t TextMap) MarshalText() ([]byte, error) {
+	buf := bytes.Buffer{}
+	if t.OrderedMap == nil {
+		return buf.Bytes(), nil
+	}
+	for e := t.order.Front(); e != nil; e = e.Next() {
+		key, value := e.Value.Key, e.Value.Value
+		if key == "" || key != strings.TrimSpace(key) || strings.ContainsAny(key, "=\r\n") || strings.HasPrefix(key, "#") {
+			return nil, fmt.Errorf("orderedmap: cannot marshal key %q as text", key)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, fmt.Errorf("orderedmap: cannot marshal value of key %q as text, it contains a line break", key)
+		}
+		buf.WriteString(key)
+		buf.WriteByte('=')
+		buf.WriteString(value)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalText parses key=value lines into the wrapped map, in the order they appear. Any existing contents of
+// the wrapped map are cleared.
+//
+// Blank lines and lines beginning with '#' are ignored. Each line is split on the first '=' only, and surrounding
+// whitespace is trimmed from the key but not the value. If a key is repeated, the later value wins while the key
+// retains its original position. A line without '=' is an error.
+func (t *TextMap) UnmarshalText(text []byte) error {
+	if t.OrderedMap == nil {
+		t.OrderedMap = New[string, string]()
+	}
+	t.Init()
+
+	for i, line := range strings.Split(string(text), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return fmt.Errorf("orderedmap: cannot unmarshal line %d as text, expected key=value", i+1)
+		}
+		t.Set(strings.TrimSpace(key), value)
+	}
+	return nil
+}
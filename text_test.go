@@ -0,0 +1,119 @@
+package orderedmap
+
+import "testing"
+
+func TestTextMap_MarshalText(t *testing.T) {
+	type testCase struct {
+		name    string
+		o       *OrderedMap[string, string]
+		want    string
+		wantErr bool
+	}
+	tests := []testCase{
+		{
+			name: "nil map is empty",
+			o:    nil,
+			want: "",
+		},
+		{
+			name: "writes key=value lines in order",
+			o:    newFromPairs(kvp("SHELL", "/bin/zsh"), kvp("OPTS", "--level=debug"), kvp("EMPTY", "")),
+			want: "SHELL=/bin/zsh\nOPTS=--level=debug\nEMPTY=\n",
+		},
+		{
+			name:    "key containing '=' is an error",
+			o:       newFromPairs(kvp("A=B", "1")),
+			wantErr: true,
+		},
+		{
+			name:    "comment key is an error",
+			o:       newFromPairs(kvp("#A", "1")),
+			wantErr: true,
+		},
+		{
+			name:    "empty key is an error",
+			o:       newFromPairs(kvp("", "1")),
+			wantErr: true,
+		},
+		{
+			name:    "key with surrounding whitespace is an error",
+			o:       newFromPairs(kvp(" A\t", "1")),
+			wantErr: true,
+		},
+		{
+			name:    "multi-line value is an error",
+			o:       newFromPairs(kvp("A", "1\n2")),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TextMap{tt.o}.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextMap_UnmarshalText(t *testing.T) {
+	type testCase struct {
+		name    string
+		text    string
+		want    *OrderedMap[string, string]
+		wantErr bool
+	}
+	tests := []testCase{
+		{
+			name: "empty text yields empty map",
+			text: "",
+			want: New[string, string](),
+		},
+		{
+			name: "parses lines in order, skipping blank lines and comments",
+			text: "# generated\nSHELL=/bin/zsh\n\n  # indented comment\r\nOPTS=--level=debug\r\n KEY = value \nEMPTY=\nSHELL=/bin/bash",
+			want: newFromPairs(kvp("SHELL", "/bin/bash"), kvp("OPTS", "--level=debug"), kvp("KEY", " value "), kvp("EMPTY", "")),
+		},
+		{
+			name:    "line without '=' is an error",
+			text:    "FIRST=1\ngarbage\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TextMap{New[string, string]().Set("stale", "value")}
+			err := got.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				compareOrderedMaps(t, tt.want, got.OrderedMap)
+			}
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		want := newFromPairs(kvp("Z", "26"), kvp("A", "x=y"), kvp("M", ""), kvp("S", " spaced "))
+		text, err := TextMap{want}.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText() error = %v", err)
+		}
+		var got TextMap
+		if err := got.UnmarshalText(text); err != nil {
+			t.Fatalf("UnmarshalText() error = %v", err)
+		}
+		compareOrderedMaps(t, want, got.OrderedMap)
+	})
+
+	t.Run("keys which would not round trip are rejected", func(t *testing.T) {
+		for _, key := range []string{" A", "A ", "\tA", " #A"} {
+			if _, err := (TextMap{newFromPairs(kvp(key, "1"))}).MarshalText(); err == nil {
+				t.Errorf("MarshalText() error = nil for key %q, want error", key)
+			}
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package orderedmap
+
+import "time"
+
+// EnableTimestamps opts in to recording the time at which each new key is inserted, exposed via InsertedAt.
+//
+// Keys which already exist when timestamps are enabled have no recorded time. If refreshOnUpdate is true, calling Set
+// for an existing key will also refresh its recorded time.
+//
+// Timestamps are disabled by default and incur no overhead until enabled.
+func (o *OrderedMap[K, V]) EnableTimestamps(refreshOnUpdate bool) *OrderedMap[K, V] {
+	if o.timestamps == nil {
+		o.timestamps = make(map[K]time.Time)
+	}
+	o.refreshTimestamps = refreshOnUpdate
+	return o
+}
+
+// InsertedAt returns the time at which key was inserted into the map.
+// Returns false if timestamps are not enabled, or if no time was recorded for key.
+func (o *OrderedMap[K, V]) InsertedAt(key K) (time.Time, bool) {
+	if o.timestamps == nil {
+		return time.Time{}, false
+	}
+	t, ok := o.timestamps[key]
+	return t, ok
+}
+
+func (o *OrderedMap[K, V]) touch(key K, updated bool) {
+	if o.timestamps == nil || (updated && !o.refreshTimestamps) {
+		return
+	}
+	o.timestamps[key] = time.Now()
+}
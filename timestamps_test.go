@@ -0,0 +1,66 @@
+package orderedmap
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderedMap_InsertedAt(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		o := newFromPairs(kvp("a", 1))
+		if _, ok := o.InsertedAt("a"); ok {
+			t.Errorf("InsertedAt() ok = true, want false when timestamps are disabled")
+		}
+	})
+
+	t.Run("ordering by timestamp matches insertion order", func(t *testing.T) {
+		o := New[string, int]().EnableTimestamps(false)
+		keys := []string{"first", "second", "third", "fourth"}
+		for i, k := range keys {
+			o.Set(k, i)
+			time.Sleep(time.Millisecond)
+		}
+		_ = o.InsertBefore("zeroth", 0, "first")
+		keys = append(keys, "zeroth")
+
+		var previous time.Time
+		for _, k := range keys {
+			at, ok := o.InsertedAt(k)
+			if !ok {
+				t.Fatalf("InsertedAt(%q) ok = false, want true", k)
+			}
+			if at.Before(previous) {
+				t.Errorf("InsertedAt(%q) = %v, which is before the previously inserted key (%v)", k, at, previous)
+			}
+			previous = at
+		}
+	})
+
+	t.Run("updates keep the original timestamp", func(t *testing.T) {
+		o := New[string, int]().EnableTimestamps(false).Set("a", 1)
+		original, _ := o.InsertedAt("a")
+		time.Sleep(time.Millisecond)
+		o.Set("a", 2)
+		if got, _ := o.InsertedAt("a"); !got.Equal(original) {
+			t.Errorf("InsertedAt() = %v, want %v", got, original)
+		}
+	})
+
+	t.Run("updates refresh the timestamp when requested", func(t *testing.T) {
+		o := New[string, int]().EnableTimestamps(true).Set("a", 1)
+		original, _ := o.InsertedAt("a")
+		time.Sleep(time.Millisecond)
+		o.Set("a", 2)
+		if got, _ := o.InsertedAt("a"); !got.After(original) {
+			t.Errorf("InsertedAt() = %v, want after %v", got, original)
+		}
+	})
+
+	t.Run("removed keys have no timestamp", func(t *testing.T) {
+		o := New[string, int]().EnableTimestamps(false).Set("a", 1)
+		o.Remove("a")
+		if _, ok := o.InsertedAt("a"); ok {
+			t.Errorf("InsertedAt() ok = true, want false for removed key")
+		}
+	})
+}
@@ -0,0 +1,258 @@
+// Package toml renders orderedmap.OrderedMap values as TOML documents, preserving key order.
+//
+// Keys are written in the map's order so that regenerated documents produce minimal diffs. Nested
+// *orderedmap.OrderedMap[string, any] values become tables, and []*orderedmap.OrderedMap[string, any] values become
+// arrays of tables. Because TOML requires a table's plain keys to precede its sub-tables, plain keys are written
+// first (in order) followed by sub-tables (in order).
+//
+// Supported values are strings, booleans, integers, floats, time.Time, slices and arrays of supported values, and
+// maps with string keys. Built-in maps are written as inline tables with sorted keys, as they have no order of their
+// own. TOML has no null, so nil values are an error.
+package toml
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	orderedmap "github.com/jimschubert/ordered-map"
+)
+
+// Table is the map type rendered as a TOML table.
+type Table = orderedmap.OrderedMap[string, any]
+
+var bareKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// Marshal renders o as a TOML document, preserving key order.
+func Marshal(o *Table) ([]byte, error) {
+	buf := bytes.Buffer{}
+	if err := Encode(&buf, o); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Encode writes o to w as a TOML document, preserving key order. A nil map writes an empty document.
+func Encode(w io.Writer, o *Table) error {
+	if o == nil {
+		return nil
+	}
+	e := &encoder{}
+	if err := e.table(nil, o); err != nil {
+		return err
+	}
+	_, err := w.Write(e.buf.Bytes())
+	return err
+}
+
+type encoder struct {
+	buf bytes.Buffer
+}
+
+func isTable(v any) bool {
+	_, ok := v.(*Table)
+	return ok
+}
+
+// isTableArray reports whether v is written as an array of tables. An empty array has no [[header]] to write, so it is
+// written inline as key = [] instead.
+func isTableArray(v any) bool {
+	tables, ok := v.([]*Table)
+	return ok && len(tables) > 0
+}
+
+// table writes the plain keys of o followed by its sub-tables, each introduced by a header derived from path.
+func (e *encoder) table(path []string, o *Table) error {
+	for key, value := range o.All() {
+		if isTable(value) || isTableArray(value) {
+			continue
+		}
+		rendered, err := e.value(value)
+		if err != nil {
+			return fmt.Errorf("toml: key %q: %w", strings.Join(append(path, key), "."), err)
+		}
+		e.buf.WriteString(quoteKey(key) + " = " + rendered + "\n")
+	}
+
+	for key, value := range o.All() {
+		switch x := value.(type) {
+		case *Table:
+			if x == nil {
+				return fmt.Errorf("toml: key %q: unsupported nil value", strings.Join(append(path, key), "."))
+			}
+			sub := append(slices.Clip(path), key)
+			e.header("[" + headerPath(sub) + "]")
+			if err := e.table(sub, x); err != nil {
+				return err
+			}
+		case []*Table:
+			sub := append(slices.Clip(path), key)
+			for _, t := range x {
+				if t == nil {
+					return fmt.Errorf("toml: key %q: unsupported nil value", headerPath(sub))
+				}
+				e.header("[[" + headerPath(sub) + "]]")
+				if err := e.table(sub, t); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (e *encoder) header(h string) {
+	if e.buf.Len() > 0 {
+		e.buf.WriteString("\n")
+	}
+	e.buf.WriteString(h + "\n")
+}
+
+func headerPath(path []string) string {
+	quoted := make([]string, len(path))
+	for i, key := range path {
+		quoted[i] = quoteKey(key)
+	}
+	return strings.Join(quoted, ".")
+}
+
+// value renders v inline, as permitted on the right-hand side of a key/value pair.
+func (e *encoder) value(v any) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", fmt.Errorf("unsupported nil value")
+	case string:
+		return quote(x), nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case time.Time:
+		return x.Format(time.RFC3339Nano), nil
+	case *Table:
+		if x == nil {
+			return "", fmt.Errorf("unsupported nil value")
+		}
+		return e.inlineTable(x.Keys(), func(key string) any {
+			value, _ := x.Get(key)
+			return *value
+		})
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return formatFloat(rv.Float()), nil
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return "[]", nil
+		}
+		items := make([]string, rv.Len())
+		for i := range items {
+			item, err := e.value(rv.Index(i).Interface())
+			if err != nil {
+				return "", err
+			}
+			items[i] = item
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return "", fmt.Errorf("unsupported map key type %s", rv.Type().Key())
+		}
+		keys := make([]string, 0, rv.Len())
+		for _, k := range rv.MapKeys() {
+			keys = append(keys, k.String())
+		}
+		slices.Sort(keys)
+		return e.inlineTable(keys, func(key string) any {
+			return rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key())).Interface()
+		})
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return "", fmt.Errorf("unsupported nil value")
+		}
+		return e.value(rv.Elem().Interface())
+	}
+	return "", fmt.Errorf("unsupported type %T", v)
+}
+
+func (e *encoder) inlineTable(keys []string, get func(key string) any) (string, error) {
+	if len(keys) == 0 {
+		return "{}", nil
+	}
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		value, err := e.value(get(key))
+		if err != nil {
+			return "", err
+		}
+		pairs[i] = quoteKey(key) + " = " + value
+	}
+	return "{ " + strings.Join(pairs, ", ") + " }", nil
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "nan"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		// TOML requires a fractional part or exponent to distinguish floats from integers
+		s += ".0"
+	}
+	return s
+}
+
+func quoteKey(key string) string {
+	if bareKey.MatchString(key) {
+		return key
+	}
+	return quote(key)
+}
+
+// quote renders s as a TOML basic string. This differs from strconv.Quote, as TOML does not support \x or \a escapes.
+func quote(s string) string {
+	buf := strings.Builder{}
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\t':
+			buf.WriteString(`\t`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\r':
+			buf.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&buf, `\u%04X`, r)
+				continue
+			}
+			buf.WriteRune(r)
+		}
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
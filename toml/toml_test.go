@@ -0,0 +1,139 @@
+package toml
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	orderedmap "github.com/jimschubert/ordered-map"
+)
+
+func TestMarshal(t *testing.T) {
+	type testCase struct {
+		name    string
+		o       *Table
+		want    string
+		wantErr string
+	}
+	tests := []testCase{
+		{
+			name: "nil map is an empty document",
+			o:    nil,
+			want: "",
+		},
+		{
+			name: "plain keys are written in order",
+			o: orderedmap.New[string, any]().
+				Set("title", "Example").
+				Set("enabled", true).
+				Set("count", 3).
+				Set("ratio", 0.5).
+				Set("whole", 2.0).
+				Set("tags", []string{"b", "a"}),
+			want: "title = \"Example\"\n" +
+				"enabled = true\n" +
+				"count = 3\n" +
+				"ratio = 0.5\n" +
+				"whole = 2.0\n" +
+				"tags = [\"b\", \"a\"]\n",
+		},
+		{
+			name: "nested maps become tables in order after plain keys",
+			o: orderedmap.New[string, any]().
+				Set("server", orderedmap.New[string, any]().
+					Set("port", 8080).
+					Set("tls", orderedmap.New[string, any]().Set("cert", "a.pem"))).
+				Set("name", "svc").
+				Set("database", orderedmap.New[string, any]().Set("url", "postgres://")),
+			want: "name = \"svc\"\n" +
+				"\n[server]\n" +
+				"port = 8080\n" +
+				"\n[server.tls]\n" +
+				"cert = \"a.pem\"\n" +
+				"\n[database]\n" +
+				"url = \"postgres://\"\n",
+		},
+		{
+			name: "arrays of tables",
+			o: orderedmap.New[string, any]().
+				Set("product", []*Table{
+					orderedmap.New[string, any]().Set("name", "Hammer").Set("sku", 738594937),
+					orderedmap.New[string, any]().Set("name", "Nail"),
+				}),
+			want: "[[product]]\n" +
+				"name = \"Hammer\"\n" +
+				"sku = 738594937\n" +
+				"\n[[product]]\n" +
+				"name = \"Nail\"\n",
+		},
+		{
+			name: "empty arrays of tables are written inline",
+			o: orderedmap.New[string, any]().
+				Set("product", []*Table{}).
+				Set("none", []*Table(nil)).
+				Set("name", "svc"),
+			want: "product = []\n" +
+				"none = []\n" +
+				"name = \"svc\"\n",
+		},
+		{
+			name: "inline tables within arrays",
+			o: orderedmap.New[string, any]().
+				Set("points", []any{
+					orderedmap.New[string, any]().Set("y", 2).Set("x", 1),
+					map[string]int{"y": 4, "x": 3},
+				}),
+			want: "points = [{ y = 2, x = 1 }, { x = 3, y = 4 }]\n",
+		},
+		{
+			name: "keys and strings are quoted where required",
+			o: orderedmap.New[string, any]().
+				Set("bare_key-1", "tab\there").
+				Set("needs quoting", "quote\" and \\ and \x01").
+				Set("ünicode", "日本"),
+			want: "bare_key-1 = \"tab\\there\"\n" +
+				"\"needs quoting\" = \"quote\\\" and \\\\ and \\u0001\"\n" +
+				"\"ünicode\" = \"日本\"\n",
+		},
+		{
+			name: "special floats and times",
+			o: orderedmap.New[string, any]().
+				Set("inf", math.Inf(1)).
+				Set("nan", math.NaN()).
+				Set("big", 1e21).
+				Set("at", time.Date(1979, 5, 27, 7, 32, 0, 0, time.UTC)),
+			want: "inf = inf\n" +
+				"nan = nan\n" +
+				"big = 1e+21\n" +
+				"at = 1979-05-27T07:32:00Z\n",
+		},
+		{
+			name:    "nil values are an error",
+			o:       orderedmap.New[string, any]().Set("missing", nil),
+			wantErr: `toml: key "missing": unsupported nil value`,
+		},
+		{
+			name:    "unsupported types are an error",
+			o:       orderedmap.New[string, any]().Set("table", orderedmap.New[string, any]().Set("fn", func() {})),
+			wantErr: `toml: key "table.fn": unsupported type func()`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Marshal(tt.o)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Marshal() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Package yaml provides order-preserving YAML encoding for orderedmap.OrderedMap, compatible with gopkg.in/yaml.v3.
+//
+// This lives in a separate package to keep yaml-specific API out of the core package, although gopkg.in/yaml.v3 is
+// still a requirement of this module. The map is encoded as a YAML mapping whose entries are written in the map's
+// order, as are any nested ordered maps.
+package yaml
+
+import (
+	"fmt"
+
+	orderedmap "github.com/jimschubert/ordered-map"
+	"github.com/jimschubert/ordered-map/internal/nested"
+	"gopkg.in/yaml.v3"
+)
+
+// Map wraps an OrderedMap to fulfill yaml.Marshaler and yaml.Unmarshaler.
+type Map[K comparable, V any] struct {
+	*orderedmap.OrderedMap[K, V]
+}
+
+// Wrap an OrderedMap for YAML encoding. If o is nil, a new OrderedMap is allocated.
+func Wrap[K comparable, V any](o *orderedmap.OrderedMap[K, V]) *Map[K, V] {
+	if o == nil {
+		o = orderedmap.New[K, V]()
+	}
+	return &Map[K, V]{OrderedMap: o}
+}
+
+// MarshalYAML encodes the wrapped map as a YAML mapping node, with entries in order.
+func (m *Map[K, V]) MarshalYAML() (interface{}, error) {
+	return Node(m.OrderedMap)
+}
+
+// UnmarshalYAML decodes a YAML mapping node into the wrapped map, preserving the document order.
+// Any existing contents of the wrapped map are cleared.
+func (m *Map[K, V]) UnmarshalYAML(node *yaml.Node) error {
+	if m.OrderedMap == nil {
+		m.OrderedMap = orderedmap.New[K, V]()
+	}
+	return Decode(node, m.OrderedMap)
+}
+
+// Marshal encodes o as a YAML document, writing entries in order. A nil map is encoded as YAML null.
+func Marshal[K comparable, V any](o *orderedmap.OrderedMap[K, V]) ([]byte, error) {
+	node, err := Node(o)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(node)
+}
+
+// Unmarshal decodes a YAML mapping from data into o, preserving the document order.
+// Any existing contents of o are cleared. The map o must not be nil.
+func Unmarshal[K comparable, V any](data []byte, o *orderedmap.OrderedMap[K, V]) error {
+	return yaml.Unmarshal(data, Wrap(o))
+}
+
+// Node encodes o as a YAML mapping node, with entries in order. A nil map is encoded as a null scalar node.
+// Values which are themselves an *orderedmap.OrderedMap, of any type parameters, are encoded in order as well.
+func Node[K comparable, V any](o *orderedmap.OrderedMap[K, V]) (*yaml.Node, error) {
+	if o == nil {
+		return nullNode(), nil
+	}
+
+	keys := make([]any, 0, o.Len())
+	values := make([]any, 0, o.Len())
+	for k, v := range o.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	return mappingNode(keys, values)
+}
+
+func nullNode() *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+}
+
+func mappingNode(keys, values []any) (*yaml.Node, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for i := range keys {
+		key := &yaml.Node{}
+		if err := key.Encode(keys[i]); err != nil {
+			return nil, err
+		}
+		value, err := valueNode(values[i])
+		if err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, key, value)
+	}
+	return node, nil
+}
+
+// valueNode encodes v, recursing into nested ordered maps which yaml.v3 would otherwise encode as empty mappings.
+func valueNode(v any) (*yaml.Node, error) {
+	if keys, values, isNil, ok := nested.Pairs(v); ok {
+		if isNil {
+			return nullNode(), nil
+		}
+		return mappingNode(keys, values)
+	}
+
+	node := &yaml.Node{}
+	if err := node.Encode(v); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Decode reads a YAML mapping node into o, preserving the document order.
+// Any existing contents of o are cleared; a null node leaves o empty. The map o must not be nil.
+func Decode[K comparable, V any](node *yaml.Node, o *orderedmap.OrderedMap[K, V]) error {
+	o.Init()
+	if node.Kind == yaml.DocumentNode && len(node.Content) == 1 {
+		node = node.Content[0]
+	}
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return nil
+	}
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("cannot decode YAML node at line %d into an ordered map: expected a mapping", node.Line)
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		var key K
+		if err := node.Content[i].Decode(&key); err != nil {
+			return err
+		}
+		var value V
+		if err := node.Content[i+1].Decode(&value); err != nil {
+			return err
+		}
+		o.Set(key, value)
+	}
+	return nil
+}
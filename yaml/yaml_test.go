@@ -0,0 +1,114 @@
+package yaml
+
+import (
+	"testing"
+
+	orderedmap "github.com/jimschubert/ordered-map"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Run("entries are written in order", func(t *testing.T) {
+		o := orderedmap.New[string, int]().
+			Set("zebra", 26).
+			Set("apple", 1).
+			Set("mango", 13)
+
+		data, err := Marshal(o)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := "zebra: 26\napple: 1\nmango: 13\n"
+		if string(data) != want {
+			t.Errorf("Marshal() = %q, want %q", data, want)
+		}
+	})
+
+	t.Run("nested wrapped maps are written in order", func(t *testing.T) {
+		inner := orderedmap.New[string, string]().Set("z", "last").Set("a", "first")
+		outer := orderedmap.New[string, *Map[string, string]]().Set("inner", Wrap(inner))
+
+		data, err := Marshal(outer)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := "inner:\n    z: last\n    a: first\n"
+		if string(data) != want {
+			t.Errorf("Marshal() = %q, want %q", data, want)
+		}
+	})
+
+	t.Run("nested raw maps are written in order", func(t *testing.T) {
+		inner := orderedmap.New[string, int]().Set("z", 26).Set("a", 1)
+		outer := orderedmap.New[string, any]().
+			Set("inner", inner).
+			Set("deeper", orderedmap.New[int, *orderedmap.OrderedMap[string, int]]().Set(1, inner)).
+			Set("nil", (*orderedmap.OrderedMap[string, int])(nil))
+
+		data, err := Marshal(outer)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := "inner:\n    z: 26\n    a: 1\ndeeper:\n    1:\n        z: 26\n        a: 1\nnil: null\n"
+		if string(data) != want {
+			t.Errorf("Marshal() = %q, want %q", data, want)
+		}
+	})
+
+	t.Run("nil map is null", func(t *testing.T) {
+		data, err := Marshal[string, int](nil)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if string(data) != "null\n" {
+			t.Errorf("Marshal() = %q, want %q", data, "null\n")
+		}
+	})
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Run("document order is preserved", func(t *testing.T) {
+		got := orderedmap.New[string, []int]().Set("stale", nil)
+		err := Unmarshal([]byte("zebra: [26]\napple: [1, 2]\nmango: []\n"), got)
+		if err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		want := orderedmap.New[string, []int]().
+			Set("zebra", []int{26}).
+			Set("apple", []int{1, 2}).
+			Set("mango", []int{})
+		if !orderedmap.Equal(want, got) {
+			t.Errorf("Unmarshal() = %#v, want %#v", got, want)
+		}
+	})
+
+	t.Run("non-mapping is an error", func(t *testing.T) {
+		got := orderedmap.New[string, int]()
+		if err := Unmarshal([]byte("- a\n- b\n"), got); err == nil {
+			t.Errorf("Unmarshal() error = nil, want an error")
+		}
+	})
+
+	t.Run("wrapped map as a struct field", func(t *testing.T) {
+		var doc struct {
+			Name   string               `yaml:"name"`
+			Fields *Map[string, string] `yaml:"fields"`
+		}
+		err := yaml.Unmarshal([]byte("name: example\nfields:\n  b: 2\n  a: 1\n"), &doc)
+		if err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		want := orderedmap.New[string, string]().Set("b", "2").Set("a", "1")
+		if !orderedmap.Equal(want, doc.Fields.OrderedMap) {
+			t.Errorf("Unmarshal() = %#v, want %#v", doc.Fields.OrderedMap, want)
+		}
+
+		data, err := yaml.Marshal(&doc)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if want := "name: example\nfields:\n    b: \"2\"\n    a: \"1\"\n"; string(data) != want {
+			t.Errorf("Marshal() = %q, want %q", data, want)
+		}
+	})
+}